@@ -10,7 +10,7 @@ import (
 	"syscall/js"
 
 	"github.com/mvndaai/sudoku_hints/sudoku"
-	"github.com/mvndaai/sudoku_hints/sudoku/boards"
+	"github.com/mvndaai/sudoku_hints/sudoku/generate"
 )
 
 func main() {
@@ -19,12 +19,21 @@ func main() {
 	m["random"] = getRandomBoard()
 	m["convertOCR"] = convertOCR()
 	m["loadBoard"] = loadBoard()
+	m["variants"] = getVariants()
 	m["next"] = next()
 	m["removeCandidate"] = removeCandidate()
+	m["nextHint"] = nextHint()
+	m["applyHint"] = applyHint()
+	m["hintLevel"] = hintLevel()
 	m["processOCR"] = processOCR()
 	m["requestDosuko"] = requestDosuko()
 	m["currentGame"] = getCurrentGame()
 	m["setCell"] = setCell()
+	m["revealCell"] = revealCell()
+	m["undo"] = undo()
+	m["redo"] = redo()
+	m["goToStep"] = goToStep()
+	m["history"] = getHistory()
 
 	js.Global().Set("golang", m)
 
@@ -54,14 +63,19 @@ func getKey() js.Func {
 func getRandomBoard() js.Func {
 	//log.Println("in getRandomBoard()")
 	return js.FuncOf(func(this js.Value, args []js.Value) any {
+		p, err := generate.Generate(generate.Options{Symmetry: generate.Rotational})
+		if err != nil {
+			return err
+		}
 		g := sudoku.Game{}
-		err := g.FillBasic(boards.RandomBasicBoard())
+		err = g.Fill(p.Cells, sudoku.DefaultGroup9x9, []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"})
 		if err != nil {
 			return err
 		}
+		g.SetRating()
 		setCurrentGame(&g)
 
-		b, err := json.Marshal(g.Board)
+		b, err := json.Marshal(g)
 		if err != nil {
 			return err
 		}
@@ -83,6 +97,8 @@ func convertOCR() js.Func {
 		if err != nil {
 			return err
 		}
+		g.Warning = g.SolutionWarning()
+		g.SetRating()
 		setCurrentGame(&g)
 
 		b, err := json.Marshal(currentGame)
@@ -108,11 +124,34 @@ func loadBoard() js.Func {
 			return fmt.Sprintf("Error parsing board: %v", err)
 		}
 
+		// The optional second argument is a JSON array of variant names
 		g := sudoku.Game{}
+		if len(args) > 1 {
+			err = json.Unmarshal([]byte(args[1].String()), &g.Variants)
+			if err != nil {
+				return fmt.Sprintf("Error parsing variants: %v", err)
+			}
+		}
+		// The optional third argument is a JSON array of killer cages
+		if len(args) > 2 {
+			err = json.Unmarshal([]byte(args[2].String()), &g.Cages)
+			if err != nil {
+				return fmt.Sprintf("Error parsing cages: %v", err)
+			}
+		}
+		// The optional fourth argument is a JSON array of line constraints
+		if len(args) > 3 {
+			err = json.Unmarshal([]byte(args[3].String()), &g.Lines)
+			if err != nil {
+				return fmt.Sprintf("Error parsing lines: %v", err)
+			}
+		}
 		err = g.FillBasic(board)
 		if err != nil {
 			return fmt.Sprintf("Error filling board: %v", err)
 		}
+		g.Warning = g.SolutionWarning()
+		g.SetRating()
 		setCurrentGame(&g)
 
 		b, err := json.Marshal(currentGame)
@@ -124,6 +163,16 @@ func loadBoard() js.Func {
 	})
 }
 
+func getVariants() js.Func {
+	return js.FuncOf(func(this js.Value, args []js.Value) any {
+		b, err := json.Marshal(sudoku.Variants)
+		if err != nil {
+			return err
+		}
+		return string(b)
+	})
+}
+
 func getCurrentGame() js.Func {
 	return js.FuncOf(func(this js.Value, args []js.Value) any {
 		//log.Println("in getCurrentGame()")
@@ -155,23 +204,150 @@ func setCell() js.Func {
 		row := args[0].Int()
 		col := args[1].Int()
 		value := args[2].String()
-		err := currentGame.SetValue(row, col, value)
+		err := currentGame.Record("Set Cell", func() error {
+			if err := currentGame.SetValue(row, col, value); err != nil {
+				return err
+			}
+			if err := currentGame.RemoveAllSimple(false); err != nil {
+				return fmt.Errorf("failed to remove all simple candidates: %w", err)
+			}
+			return nil
+		})
 		if err != nil {
 			return err
 		}
-		err = currentGame.RemoveAllSimple(false)
+
+		b, err := json.Marshal(currentGame)
+		if err != nil {
+			return err
+		}
+		return string(b)
+	})
+}
+
+func revealCell() js.Func {
+	return js.FuncOf(func(this js.Value, args []js.Value) any {
+		currentGameMutex.Lock()
+		defer currentGameMutex.Unlock()
+		if currentGame == nil {
+			return "No current game"
+		}
+
+		if len(args) < 2 {
+			return "Insufficient arguments"
+		}
+
+		row := args[0].Int()
+		col := args[1].Int()
+		if !currentGame.OnBoard(sudoku.Loc{X: col, Y: row}) {
+			return fmt.Sprintf("Error revealing cell: (%d, %d) is outside of the board", row, col)
+		}
+		if currentGame.Board[row][col].Cell.Value != "" {
+			b, err := json.Marshal(currentGame)
+			if err != nil {
+				return fmt.Sprintf("Error marshaling game: %v", err)
+			}
+			return string(b)
+		}
+
+		solution, err := currentGame.Solve()
+		if err != nil {
+			return fmt.Sprintf("Error solving board: %v", err)
+		}
+		if row >= len(solution) || col >= len(solution[row]) {
+			return fmt.Sprintf("Error revealing cell: (%d, %d) is outside of the solution", row, col)
+		}
+		err = currentGame.Record("Reveal Cell", func() error {
+			if err := currentGame.SetValue(row, col, solution[row][col]); err != nil {
+				return err
+			}
+			if err := currentGame.RemoveAllSimple(false); err != nil {
+				return fmt.Errorf("failed to remove all simple candidates: %w", err)
+			}
+			return nil
+		})
 		if err != nil {
-			return fmt.Errorf("failed to remove all simple candidates: %w", err)
+			return fmt.Sprintf("Error revealing cell: %v", err)
 		}
 
 		b, err := json.Marshal(currentGame)
 		if err != nil {
-			return err
+			return fmt.Sprintf("Error marshaling game: %v", err)
 		}
 		return string(b)
 	})
 }
 
+// historyResult returns the game with the steps that can be undone and redone
+func historyResult() any {
+	b, err := json.Marshal(currentGame)
+	if err != nil {
+		return fmt.Sprintf("Error marshaling game: %v", err)
+	}
+	result := map[string]any{
+		"game":    string(b),
+		"history": currentGame.History,
+	}
+	resultJSON, _ := json.Marshal(result)
+	return string(resultJSON)
+}
+
+func undo() js.Func {
+	return js.FuncOf(func(this js.Value, args []js.Value) any {
+		currentGameMutex.Lock()
+		defer currentGameMutex.Unlock()
+		if currentGame == nil {
+			return "No current game"
+		}
+		if !currentGame.Undo() {
+			return "No steps to undo"
+		}
+		return historyResult()
+	})
+}
+
+func redo() js.Func {
+	return js.FuncOf(func(this js.Value, args []js.Value) any {
+		currentGameMutex.Lock()
+		defer currentGameMutex.Unlock()
+		if currentGame == nil {
+			return "No current game"
+		}
+		if !currentGame.Redo() {
+			return "No steps to redo"
+		}
+		return historyResult()
+	})
+}
+
+func goToStep() js.Func {
+	return js.FuncOf(func(this js.Value, args []js.Value) any {
+		currentGameMutex.Lock()
+		defer currentGameMutex.Unlock()
+		if currentGame == nil {
+			return "No current game"
+		}
+		if len(args) < 1 {
+			return "Insufficient arguments"
+		}
+		if err := currentGame.GoToStep(args[0].Int()); err != nil {
+			return fmt.Sprintf("Error going to step: %v", err)
+		}
+		return historyResult()
+	})
+}
+
+func getHistory() js.Func {
+	return js.FuncOf(func(this js.Value, args []js.Value) any {
+		currentGameMutex.Lock()
+		defer currentGameMutex.Unlock()
+		if currentGame == nil {
+			return "No current game"
+		}
+		return historyResult()
+	})
+}
+
 func next() js.Func {
 	return js.FuncOf(func(this js.Value, args []js.Value) any {
 		if len(args) > 1 {
@@ -206,7 +382,7 @@ func removeCandidate() js.Func {
 			return "No current game"
 		}
 
-		ok, change, err := currentGame.RemoveOneCandidate(false)
+		ok, hint, err := currentGame.RemoveOneCandidate(false)
 		if err != nil {
 			return fmt.Sprintf("Error removing candidate: %v", err)
 		}
@@ -219,10 +395,106 @@ func removeCandidate() js.Func {
 			return fmt.Sprintf("Error marshaling game: %v", err)
 		}
 
-		// Return both the game state and the change message separated by a delimiter
-		result := map[string]string{
+		// Return the game state, the change message and the hint so the UI can highlight it
+		result := map[string]any{
+			"game":   string(b),
+			"change": fmt.Sprintf("[%s] %s", hint.Eliminator, hint),
+			"hint":   hint,
+		}
+		resultJSON, _ := json.Marshal(result)
+		return string(resultJSON)
+	})
+}
+
+// nextHint returns the hint the next step would apply without changing the board
+func nextHint() js.Func {
+	return js.FuncOf(func(this js.Value, args []js.Value) any {
+		currentGameMutex.Lock()
+		defer currentGameMutex.Unlock()
+		if currentGame == nil {
+			return "No current game"
+		}
+
+		ok, hint, err := currentGame.NextHint()
+		if err != nil {
+			return fmt.Sprintf("Error finding hint: %v", err)
+		}
+		if !ok {
+			return "No hints found"
+		}
+
+		result := map[string]any{
+			"change": fmt.Sprintf("[%s] %s", hint.Eliminator, hint),
+			"hint":   hint,
+		}
+		resultJSON, _ := json.Marshal(result)
+		return string(resultJSON)
+	})
+}
+
+// applyHint applies a hint returned by nextHint
+func applyHint() js.Func {
+	return js.FuncOf(func(this js.Value, args []js.Value) any {
+		currentGameMutex.Lock()
+		defer currentGameMutex.Unlock()
+		if currentGame == nil {
+			return "No current game"
+		}
+		if len(args) < 1 {
+			return "Insufficient arguments"
+		}
+
+		var hint sudoku.Hint
+		if err := json.Unmarshal([]byte(args[0].String()), &hint); err != nil {
+			return fmt.Sprintf("Error invalid hint: %v", err)
+		}
+		if err := currentGame.ApplyHint(hint); err != nil {
+			return fmt.Sprintf("Error applying hint: %v", err)
+		}
+
+		b, err := json.Marshal(currentGame)
+		if err != nil {
+			return fmt.Sprintf("Error marshaling game: %v", err)
+		}
+		result := map[string]any{
+			"game":   string(b),
+			"change": fmt.Sprintf("[%s] %s", hint.Eliminator, hint),
+			"hint":   hint,
+		}
+		resultJSON, _ := json.Marshal(result)
+		return string(resultJSON)
+	})
+}
+
+// hintLevel gives the next hint at a level from 1 to 4, only level 4 applies it
+func hintLevel() js.Func {
+	return js.FuncOf(func(this js.Value, args []js.Value) any {
+		currentGameMutex.Lock()
+		defer currentGameMutex.Unlock()
+		if currentGame == nil {
+			return "No current game"
+		}
+		if len(args) < 1 {
+			return "Insufficient arguments"
+		}
+
+		ok, lh, err := currentGame.HintAt(sudoku.HintLevel(args[0].Int()))
+		if err != nil {
+			return fmt.Sprintf("Error finding hint: %v", err)
+		}
+		if !ok {
+			return "No hints found"
+		}
+
+		b, err := json.Marshal(currentGame)
+		if err != nil {
+			return fmt.Sprintf("Error marshaling game: %v", err)
+		}
+		result := map[string]any{
 			"game":   string(b),
-			"change": change,
+			"level":  lh.Level,
+			"change": lh.Message,
+			"hint":   lh.Hint,
 		}
 		resultJSON, _ := json.Marshal(result)
 		return string(resultJSON)
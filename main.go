@@ -11,6 +11,8 @@ import (
 
 func main() {
 	g := sudoku.Game{}
+	//g.Variants = []string{sudoku.VariantFistemafelRing} // Declare the variant rules of the puzzle before filling it
+	//g.AntiKnight = true // Chess-move and non-consecutive rules are flags set before filling too
 	err := g.FillBasic(boards.NYTHard17July2025)
 	//err := g.FillBasic(boards.NYTHard2June2025)
 	//err := g.FillBasic(boards.BasicEasy)
@@ -18,10 +20,16 @@ func main() {
 	if err != nil {
 		log.Fatalf("Failed to fill game: %v", err)
 	}
+	if warning := g.SolutionWarning(); warning != "" {
+		log.Println("Warning:", warning)
+	}
+	g.SetRating()
+	log.Printf("Difficulty: %s (score %.1f, total %.1f, hardest %s)", g.Rating.Category, g.Rating.Score, g.Rating.Total, g.Rating.Hardest)
 
 	//g.HideSimple = true // Hide basic eliminators
 	//g.RandomEliminators = true // Randomize the order of eliminators TODO this causes errors.
 	g.RunSimpleFirst = true // Run simple eliminators first quietly
 	//g.AutoSolve = true      // Automatically solve the game
+	//g.HintLevels = true     // Show each elimination one hint level at a time
 	g.StepThroughConsole()
 }
@@ -0,0 +1,347 @@
+package sudoku
+
+import (
+	"fmt"
+	"slices"
+)
+
+// maxALSSize limits how many cells an almost locked set can have to keep the search fast.
+const maxALSSize = 5
+
+// ALS is an almost locked set, N cells in one house that hold N+1 candidates between them.
+type ALS struct {
+	House      string   `json:"house"` // "row", "column" or "group"
+	Index      int      `json:"index"`
+	Cells      []Loc    `json:"cells"`
+	Candidates []string `json:"candidates"`
+
+	cells []LocCell
+}
+
+func (a ALS) String() string {
+	return fmt.Sprintf("%s %d %s %v", a.House, a.Index, formatLocs(a.Cells), a.Candidates)
+}
+
+// ALSHint describes the almost locked sets and the restricted common candidates that link them.
+type ALSHint struct {
+	Name string     `json:"name"`
+	Sets []ALS      `json:"sets"`
+	RCCs [][]string `json:"rccs"`           // RCCs[i] links Sets[0] and Sets[i+1], or the stem and Sets[i] for a Death Blossom
+	Stem *Loc       `json:"stem,omitempty"` // The cell each Death Blossom petal is linked to
+}
+
+func (h ALSHint) String() string {
+	s := "with " + h.Name
+	if h.Stem != nil {
+		s += " stem " + formatLocs([]Loc{*h.Stem})
+	}
+	for i, set := range h.Sets {
+		if i != 0 {
+			s += " and"
+		}
+		s += " " + set.String()
+	}
+	return s + fmt.Sprintf(" linked by %v", h.RCCs)
+}
+
+func (h ALSHint) explain(g *Game) ([]Cause, []House) {
+	causes := []Cause{}
+	houses := []House{}
+	if h.Stem != nil {
+		causes = g.causes([]Loc{*h.Stem})
+	}
+	for _, set := range h.Sets {
+		causes = mergeCauses(causes, g.causes(set.Cells, set.Candidates...)...)
+		houses = addHouses(houses, House{Type: set.House, Index: set.Index})
+	}
+	return causes, houses
+}
+
+// locsWith returns the cells of the set holding the candidate.
+func (a ALS) locsWith(symbol string) []Loc {
+	locs := []Loc{}
+	for _, lc := range a.cells {
+		if lc.Cell.HasCandidate(symbol) {
+			locs = append(locs, lc.Loc)
+		}
+	}
+	return locs
+}
+
+func (a ALS) overlaps(b ALS) bool {
+	return slices.ContainsFunc(a.Cells, func(l Loc) bool { return slices.Contains(b.Cells, l) })
+}
+
+// almostLockedSets returns every ALS in the rows, columns and groups. A set found in more than one house is only returned once.
+func (g *Game) almostLockedSets() []ALS {
+	rows, cols, groups := g.GetSectionedCells()
+	houses := []struct {
+		name  string
+		cells [][]LocCell
+	}{
+		{"row", rows},
+		{"column", cols},
+		{"group", groups},
+	}
+
+	seen := map[string]struct{}{}
+	sets := []ALS{}
+	for _, house := range houses {
+		for i, cells := range house.cells {
+			unsolved := slices.DeleteFunc(slices.Clone(cells), func(lc LocCell) bool { return lc.Cell.Value != "" })
+			for size := 1; size <= maxALSSize && size < len(unsolved); size++ {
+				for _, combo := range getCombinations(unsolved, size) {
+					candidates := unionCandidates(combo)
+					if len(candidates) != size+1 {
+						continue
+					}
+					set := ALS{House: house.name, Index: i, Candidates: candidates, cells: combo}
+					for _, lc := range combo {
+						set.Cells = append(set.Cells, lc.Loc)
+					}
+					key := Locs(slices.Clone(set.Cells)).Key()
+					if _, ok := seen[key]; ok {
+						continue
+					}
+					seen[key] = struct{}{}
+					sets = append(sets, set)
+				}
+			}
+		}
+	}
+	return sets
+}
+
+// restrictedCommons returns the candidates of two separate sets where every cell holding it in one set sees every cell holding it in the other.
+// Only one of the sets can hold such a candidate.
+func (g *Game) restrictedCommons(a, b ALS) []string {
+	if a.overlaps(b) {
+		return nil
+	}
+	rccs := []string{}
+	for _, symbol := range a.Candidates {
+		if !slices.Contains(b.Candidates, symbol) {
+			continue
+		}
+		inB := b.locsWith(symbol)
+		if slices.ContainsFunc(a.locsWith(symbol), func(l Loc) bool { return !g.allSee(append([]Loc{l}, inB...)) }) {
+			continue
+		}
+		rccs = append(rccs, symbol)
+	}
+	return rccs
+}
+
+// seesAllWith returns a cell with the candidate that sees every location, so it cannot be the candidate.
+func (g *Game) seesAllWith(symbol string, locs []Loc) (LocCell, bool) {
+	for _, lc := range g.CommonPeers(locs) {
+		if lc.Cell.HasCandidate(symbol) {
+			return lc, true
+		}
+	}
+	return LocCell{}, false
+}
+
+func alsHint(eliminator string, target LocCell, symbol string, details ALSHint) Hint {
+	if details.Name == "" {
+		details.Name = eliminator
+	}
+	return Hint{
+		Loc:                target.Loc,
+		CandidatesToRemove: []string{symbol},
+		Eliminator:         eliminator,
+		cell:               target.Cell,
+		ALS:                &details,
+	}
+}
+
+var EliminatorALSXZ = func() CandidateEliminator {
+	name := "ALS-XZ"
+	r := CandidateEliminator{
+		Name:        name,
+		Description: "Two almost locked sets share a restricted common candidate X, so only one of them can hold it and the other becomes locked. A candidate Z in both sets is removed from cells seeing every Z in them. With two restricted commons both sets lock.",
+		Weight:      7.5,
+		GameHinter: func(g *Game) (bool, Hint, error) {
+			sets := g.almostLockedSets()
+			for i, a := range sets {
+				for _, b := range sets[i+1:] {
+					rccs := g.restrictedCommons(a, b)
+					if len(rccs) == 0 {
+						continue
+					}
+					details := ALSHint{Sets: []ALS{a, b}, RCCs: [][]string{rccs}}
+
+					if len(rccs) == 1 {
+						for _, z := range a.Candidates {
+							if z == rccs[0] || !slices.Contains(b.Candidates, z) {
+								continue
+							}
+							if target, ok := g.seesAllWith(z, append(a.locsWith(z), b.locsWith(z)...)); ok {
+								return true, alsHint(name, target, z, details), nil
+							}
+						}
+						continue
+					}
+
+					// Doubly linked: each restricted common is in one of the sets and the rest of each set is locked
+					details.Name = "Doubly Linked " + name
+					for _, x := range rccs {
+						if target, ok := g.seesAllWith(x, append(a.locsWith(x), b.locsWith(x)...)); ok {
+							return true, alsHint(name, target, x, details), nil
+						}
+					}
+					for _, set := range []ALS{a, b} {
+						for _, z := range set.Candidates {
+							if slices.Contains(rccs, z) {
+								continue
+							}
+							if target, ok := g.seesAllWith(z, set.locsWith(z)); ok {
+								return true, alsHint(name, target, z, details), nil
+							}
+						}
+					}
+				}
+			}
+			return false, Hint{}, nil
+		},
+	}
+
+	r.GameEliminator = GameHinterToEliminator(r.GameHinter)
+	return r
+}()
+
+var EliminatorALSXYWing = func() CandidateEliminator {
+	name := "ALS-XY-Wing"
+	r := CandidateEliminator{
+		Name:        name,
+		Description: "A pivot almost locked set links to two others with different restricted commons X and Y. One of the other two sets must lock, so a candidate Z in both is removed from cells seeing every Z in them.",
+		Weight:      7.8,
+		GameHinter: func(g *Game) (bool, Hint, error) {
+			sets := g.almostLockedSets()
+			for _, pivot := range sets {
+				type link struct {
+					set  ALS
+					rccs []string
+				}
+				links := []link{}
+				for _, other := range sets {
+					if rccs := g.restrictedCommons(pivot, other); len(rccs) > 0 {
+						links = append(links, link{set: other, rccs: rccs})
+					}
+				}
+
+				for i, b := range links {
+					for _, c := range links[i+1:] {
+						if b.set.overlaps(c.set) {
+							continue
+						}
+						for _, x := range b.rccs {
+							for _, y := range c.rccs {
+								if x == y {
+									continue
+								}
+								for _, z := range b.set.Candidates {
+									if z == x || z == y || !slices.Contains(c.set.Candidates, z) {
+										continue
+									}
+									target, ok := g.seesAllWith(z, append(b.set.locsWith(z), c.set.locsWith(z)...))
+									if !ok {
+										continue
+									}
+									return true, alsHint(name, target, z, ALSHint{
+										Sets: []ALS{pivot, b.set, c.set},
+										RCCs: [][]string{{x}, {y}},
+									}), nil
+								}
+							}
+						}
+					}
+				}
+			}
+			return false, Hint{}, nil
+		},
+	}
+
+	r.GameEliminator = GameHinterToEliminator(r.GameHinter)
+	return r
+}()
+
+var EliminatorDeathBlossom = func() CandidateEliminator {
+	name := "Death Blossom"
+	r := CandidateEliminator{
+		Name:        name,
+		Description: "Each candidate of a stem cell is linked to its own almost locked set. Whichever candidate the stem takes locks that set, so a candidate Z in every set is removed from cells seeing every Z in them.",
+		Weight:      8.5,
+		GameHinter: func(g *Game) (bool, Hint, error) {
+			sets := g.almostLockedSets()
+			for y := range g.Board {
+				for x := range g.Board[y] {
+					stem := LocCell{Loc: Loc{X: x, Y: y}, Cell: g.Board[y][x].Cell}
+					if n := stem.Cell.CandidateCount(); n < 2 || n > 3 {
+						continue
+					}
+
+					// Find the sets each stem candidate could force to lock
+					petals := make([][]ALS, stem.Cell.CandidateCount())
+					for i, symbol := range stem.Cell.Candidates() {
+						for _, set := range sets {
+							if !slices.Contains(set.Candidates, symbol) || slices.Contains(set.Cells, stem.Loc) {
+								continue
+							}
+							if g.allSee(append([]Loc{stem.Loc}, set.locsWith(symbol)...)) {
+								petals[i] = append(petals[i], set)
+							}
+						}
+					}
+
+					if ok, h := g.deathBlossom(name, stem, petals, []ALS{}); ok {
+						return true, h, nil
+					}
+				}
+			}
+			return false, Hint{}, nil
+		},
+	}
+
+	r.GameEliminator = GameHinterToEliminator(r.GameHinter)
+	return r
+}()
+
+// deathBlossom picks one separate petal for each stem candidate and looks for a candidate they all share.
+func (g *Game) deathBlossom(name string, stem LocCell, petals [][]ALS, chosen []ALS) (bool, Hint) {
+	if len(chosen) < len(petals) {
+		for _, petal := range petals[len(chosen)] {
+			if slices.ContainsFunc(chosen, petal.overlaps) {
+				continue
+			}
+			if ok, h := g.deathBlossom(name, stem, petals, append(chosen, petal)); ok {
+				return true, h
+			}
+		}
+		return false, Hint{}
+	}
+
+	for _, z := range chosen[0].Candidates {
+		if stem.Cell.HasCandidate(z) || slices.ContainsFunc(chosen, func(a ALS) bool { return !slices.Contains(a.Candidates, z) }) {
+			continue
+		}
+		locs := []Loc{}
+		for _, petal := range chosen {
+			locs = append(locs, petal.locsWith(z)...)
+		}
+		target, ok := g.seesAllWith(z, locs)
+		if !ok {
+			continue
+		}
+		rccs := [][]string{}
+		for _, symbol := range stem.Cell.Candidates() {
+			rccs = append(rccs, []string{symbol})
+		}
+		return true, alsHint(name, target, z, ALSHint{
+			Sets: slices.Clone(chosen),
+			RCCs: rccs,
+			Stem: &stem.Loc,
+		})
+	}
+	return false, Hint{}
+}
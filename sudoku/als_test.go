@@ -0,0 +1,113 @@
+package sudoku
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlmostLockedSets(t *testing.T) {
+	g := emptyGame(t)
+	setCandidates(g, Loc{X: 0, Y: 0}, "1", "2")
+	setCandidates(g, Loc{X: 1, Y: 0}, "2", "3")
+
+	sets := g.almostLockedSets()
+	cells := [][]Loc{}
+	for _, set := range sets {
+		cells = append(cells, set.Cells)
+	}
+	assert.ElementsMatch(t, [][]Loc{
+		{{X: 0, Y: 0}},
+		{{X: 1, Y: 0}},
+		{{X: 0, Y: 0}, {X: 1, Y: 0}},
+	}, cells)
+	assert.Equal(t, "row 0 (x:0,y:0) (x:1,y:0) [1 2 3]", sets[2].String())
+}
+
+func TestEliminatorALS(t *testing.T) {
+	tests := []struct {
+		name       string
+		eliminator CandidateEliminator
+		candidates map[Loc][]string
+		expected   []string
+	}{
+		{
+			name:       "ALS-XZ",
+			eliminator: EliminatorALSXZ,
+			candidates: map[Loc][]string{
+				{X: 0, Y: 0}: {"1", "2"},
+				{X: 0, Y: 4}: {"1", "3"},
+				{X: 1, Y: 4}: {"2", "3"},
+			},
+			expected: []string{
+				"removed candidates (x:1,y:0) [2] with ALS-XZ row 0 (x:0,y:0) [1 2] and row 4 (x:0,y:4) (x:1,y:4) [1 2 3] linked by [[1]]",
+				"removed candidates (x:1,y:1) [2] with ALS-XZ row 0 (x:0,y:0) [1 2] and row 4 (x:0,y:4) (x:1,y:4) [1 2 3] linked by [[1]]",
+				"removed candidates (x:1,y:2) [2] with ALS-XZ row 0 (x:0,y:0) [1 2] and row 4 (x:0,y:4) (x:1,y:4) [1 2 3] linked by [[1]]",
+				"removed candidates (x:0,y:3) [2] with ALS-XZ row 0 (x:0,y:0) [1 2] and row 4 (x:0,y:4) (x:1,y:4) [1 2 3] linked by [[1]]",
+				"removed candidates (x:0,y:5) [2] with ALS-XZ row 0 (x:0,y:0) [1 2] and row 4 (x:0,y:4) (x:1,y:4) [1 2 3] linked by [[1]]",
+			},
+		},
+		{
+			name:       "Doubly Linked ALS-XZ",
+			eliminator: EliminatorALSXZ,
+			candidates: map[Loc][]string{
+				{X: 0, Y: 0}: {"1", "2"},
+				{X: 0, Y: 4}: {"1", "2"},
+			},
+			expected: []string{
+				"removed candidates (x:0,y:1) [1] with Doubly Linked ALS-XZ row 0 (x:0,y:0) [1 2] and row 4 (x:0,y:4) [1 2] linked by [[1 2]]",
+				"removed candidates (x:0,y:2) [1] with Doubly Linked ALS-XZ row 0 (x:0,y:0) [1 2] and row 4 (x:0,y:4) [1 2] linked by [[1 2]]",
+				"removed candidates (x:0,y:3) [1] with Doubly Linked ALS-XZ row 0 (x:0,y:0) [1 2] and row 4 (x:0,y:4) [1 2] linked by [[1 2]]",
+				"removed candidates (x:0,y:5) [1] with Doubly Linked ALS-XZ row 0 (x:0,y:0) [1 2] and row 4 (x:0,y:4) [1 2] linked by [[1 2]]",
+				"removed candidates (x:0,y:6) [1] with Doubly Linked ALS-XZ row 0 (x:0,y:0) [1 2] and row 4 (x:0,y:4) [1 2] linked by [[1 2]]",
+				"removed candidates (x:0,y:7) [1] with Doubly Linked ALS-XZ row 0 (x:0,y:0) [1 2] and row 4 (x:0,y:4) [1 2] linked by [[1 2]]",
+				"removed candidates (x:0,y:8) [1] with Doubly Linked ALS-XZ row 0 (x:0,y:0) [1 2] and row 4 (x:0,y:4) [1 2] linked by [[1 2]]",
+				"removed candidates (x:0,y:1) [2] with Doubly Linked ALS-XZ row 0 (x:0,y:0) [1 2] and row 4 (x:0,y:4) [1 2] linked by [[1 2]]",
+				"removed candidates (x:0,y:2) [2] with Doubly Linked ALS-XZ row 0 (x:0,y:0) [1 2] and row 4 (x:0,y:4) [1 2] linked by [[1 2]]",
+				"removed candidates (x:0,y:3) [2] with Doubly Linked ALS-XZ row 0 (x:0,y:0) [1 2] and row 4 (x:0,y:4) [1 2] linked by [[1 2]]",
+				"removed candidates (x:0,y:5) [2] with Doubly Linked ALS-XZ row 0 (x:0,y:0) [1 2] and row 4 (x:0,y:4) [1 2] linked by [[1 2]]",
+				"removed candidates (x:0,y:6) [2] with Doubly Linked ALS-XZ row 0 (x:0,y:0) [1 2] and row 4 (x:0,y:4) [1 2] linked by [[1 2]]",
+				"removed candidates (x:0,y:7) [2] with Doubly Linked ALS-XZ row 0 (x:0,y:0) [1 2] and row 4 (x:0,y:4) [1 2] linked by [[1 2]]",
+				"removed candidates (x:0,y:8) [2] with Doubly Linked ALS-XZ row 0 (x:0,y:0) [1 2] and row 4 (x:0,y:4) [1 2] linked by [[1 2]]",
+			},
+		},
+		{
+			name:       "ALS-XY-Wing",
+			eliminator: EliminatorALSXYWing,
+			candidates: map[Loc][]string{
+				{X: 0, Y: 0}: {"1", "2"},
+				{X: 0, Y: 4}: {"1", "3"},
+				{X: 4, Y: 0}: {"2", "3"},
+			},
+			expected: []string{
+				"removed candidates (x:4,y:4) [3] with ALS-XY-Wing row 0 (x:0,y:0) [1 2] and row 0 (x:4,y:0) [2 3] and row 4 (x:0,y:4) [1 3] linked by [[2] [1]]",
+			},
+		},
+		{
+			name:       "Death Blossom",
+			eliminator: EliminatorDeathBlossom,
+			candidates: map[Loc][]string{
+				{X: 4, Y: 4}: {"1", "2"},
+				{X: 4, Y: 0}: {"1", "3"},
+				{X: 0, Y: 4}: {"2", "3"},
+			},
+			expected: []string{
+				"removed candidates (x:0,y:0) [3] with Death Blossom stem (x:4,y:4) row 0 (x:4,y:0) [1 3] and row 4 (x:0,y:4) [2 3] linked by [[1] [2]]",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := emptyGame(t)
+			for loc, candidates := range tt.candidates {
+				setCandidates(g, loc, candidates...)
+			}
+
+			changes := collectChanges(t, g, tt.eliminator)
+			assert.Equal(t, tt.expected, changes)
+			require.NoError(t, g.BadBoard())
+		})
+	}
+}
@@ -0,0 +1,376 @@
+package sudoku
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Cage is a killer cage, a set of cells whose values add up to Sum without repeating.
+type Cage struct {
+	Sum   int   `json:"sum"`
+	Cells []Loc `json:"cells"`
+}
+
+// FillKiller fills a 9x9 killer puzzle, where the cages give the sums of their cells.
+func (g *Game) FillKiller(cells [][]int, cages []Cage) error {
+	g.Cages = cages
+	return g.FillBasic(cells)
+}
+
+// checkCages makes sure the cages are on the board, do not share cells and can be added up.
+func (g *Game) checkCages() error {
+	if len(g.Cages) == 0 {
+		return nil
+	}
+	if err := g.checkSymbolNumbers(); err != nil {
+		return fmt.Errorf("cages need numbers: %w", err)
+	}
+
+	seen := map[Loc]int{}
+	for i, c := range g.Cages {
+		if len(c.Cells) == 0 || len(c.Cells) > len(g.Symbols) {
+			return fmt.Errorf("cage %d has %d cells, it needs between 1 and %d", i, len(c.Cells), len(g.Symbols))
+		}
+		for _, l := range c.Cells {
+			if !g.OnBoard(l) {
+				return fmt.Errorf("cage %d has %v outside of the board", i, l)
+			}
+			if j, ok := seen[l]; ok {
+				return fmt.Errorf("cages %d and %d both have %v", j, i, l)
+			}
+			seen[l] = i
+		}
+	}
+	return nil
+}
+
+// cageOf returns the index of the cage holding the location, or -1 when it is not in a cage.
+func (g *Game) cageOf(l Loc) int {
+	return slices.IndexFunc(g.Cages, func(c Cage) bool { return slices.Contains(c.Cells, l) })
+}
+
+// cageCells returns the cells of each cage, in the order of Game.Cages.
+func (g *Game) cageCells() [][]LocCell {
+	cages := make([][]LocCell, len(g.Cages))
+	for i, c := range g.Cages {
+		cages[i] = g.locCells(c.Cells)
+	}
+	return cages
+}
+
+// cageOptions returns the symbols each cell can hold in some way of filling the cage with different values that add
+// up to sum, along with every set of symbols that fills it. There are no fills when the cage cannot be filled.
+func (g *Game) cageOptions(cells []LocCell, sum int) (options []CandidateSet, fills []CandidateSet) {
+	options = make([]CandidateSet, len(cells))
+	if len(cells) == 0 {
+		return options, nil
+	}
+	index := cells[0].Cell.index()
+	total := func(s CandidateSet) int {
+		n := 0
+		for _, i := range s.Indexes() {
+			v, _ := g.symbolNumber(index.symbol(i))
+			n += v
+		}
+		return n
+	}
+
+	choices := make([]CandidateSet, len(cells))
+	for i, lc := range cells {
+		choices[i] = lc.Cell.candidates
+		if lc.Cell.Value != "" {
+			choices[i] = lc.Cell.valueSet()
+		}
+	}
+
+	// Every set of symbols the first i cells can hold, then only the ones that lead to a full cage with the sum
+	reachable := make([]map[CandidateSet]bool, len(cells)+1)
+	reachable[0] = map[CandidateSet]bool{0: true}
+	for i, choice := range choices {
+		reachable[i+1] = map[CandidateSet]bool{}
+		for used := range reachable[i] {
+			for _, s := range (choice &^ used).Indexes() {
+				reachable[i+1][used.With(s)] = true
+			}
+		}
+	}
+
+	valid := map[CandidateSet]bool{}
+	for used := range reachable[len(cells)] {
+		if total(used) == sum {
+			valid[used] = true
+			fills = append(fills, used)
+		}
+	}
+	for i := len(cells) - 1; i >= 0; i-- {
+		next := valid
+		valid = map[CandidateSet]bool{}
+		for used := range reachable[i] {
+			for _, s := range (choices[i] &^ used).Indexes() {
+				if next[used.With(s)] {
+					options[i] = options[i].With(s)
+					valid[used] = true
+				}
+			}
+		}
+	}
+	return options, fills
+}
+
+// checkCageSums returns an error for the first cage that cannot add up to its sum.
+func (g *Game) checkCageSums() error {
+	for i, cells := range g.cageCells() {
+		if _, fills := g.cageOptions(cells, g.Cages[i].Sum); len(fills) == 0 {
+			return fmt.Errorf("cage %d cannot add up to %d", i, g.Cages[i].Sum)
+		}
+	}
+	return nil
+}
+
+// CageHint describes the killer cages that make a hint.
+type CageHint struct {
+	Rule   string `json:"rule"`             // "combinations", "innie", "outie" or "required"
+	Cages  []int  `json:"cages"`            // Indexes in Game.Cages
+	Sum    int    `json:"sum"`              // Sum of the cage, or what the innie or outie must be
+	House  string `json:"house,omitempty"`  // The house of an innie or outie
+	Symbol string `json:"symbol,omitempty"` // The symbol the cage must hold
+	Cells  []Loc  `json:"cells"`            // Cells of the cages
+}
+
+func (c CageHint) String() string {
+	switch c.Rule {
+	case "innie":
+		if len(c.Cages) == 0 {
+			return fmt.Sprintf("because the one cell of %s must be %d", c.House, c.Sum)
+		}
+		return fmt.Sprintf("because the one cell of %s outside cages %v must be %d", c.House, c.Cages, c.Sum)
+	case "outie":
+		return fmt.Sprintf("because the one cell of cages %v outside %s must be %d", c.Cages, c.House, c.Sum)
+	case "required":
+		return fmt.Sprintf("because cage %d must hold %s", c.Cages[0], c.Symbol)
+	}
+	return fmt.Sprintf("because no combination for cage %d adding up to %d uses them", c.Cages[0], c.Sum)
+}
+
+func (c CageHint) explain(g *Game) ([]Cause, []House) {
+	houses := []House{}
+	for _, i := range c.Cages {
+		houses = append(houses, House{Type: "cage", Index: i})
+	}
+	return g.causes(c.Cells), houses
+}
+
+var EliminatorCageCombinations = func() CandidateEliminator {
+	name := "Cage Combinations"
+	r := CandidateEliminator{
+		Name:        name,
+		Description: "Removes the candidates of a killer cage that no combination of different digits adding up to its sum can use.",
+		Weight:      1.8,
+		GameHinter: func(g *Game) (bool, Hint, error) {
+			for i, cells := range g.cageCells() {
+				options, fills := g.cageOptions(cells, g.Cages[i].Sum)
+				if len(fills) == 0 {
+					continue // BadBoard reports cages that cannot be filled
+				}
+				for j, lc := range cells {
+					if lc.Cell.Value != "" {
+						continue
+					}
+					if remove := lc.Cell.candidates &^ options[j]; remove != 0 {
+						return true, Hint{
+							Loc:                lc.Loc,
+							Eliminator:         name,
+							CandidatesToRemove: lc.Cell.index().strings(remove),
+							cell:               lc.Cell,
+							Cage:               &CageHint{Rule: "combinations", Cages: []int{i}, Sum: g.Cages[i].Sum, Cells: g.Cages[i].Cells},
+						}, nil
+					}
+				}
+			}
+			return false, Hint{}, nil
+		},
+	}
+
+	r.GameEliminator = GameHinterToEliminator(r.GameHinter)
+	return r
+}()
+
+var EliminatorInniesOuties = func() CandidateEliminator {
+	name := "Innies and Outies"
+	r := CandidateEliminator{
+		Name:        name,
+		Description: "A house holds every digit once so it always adds up to the same total. The one cell a house has outside of the cages inside it, or the one cell the cages covering it have outside of it, makes up the difference.",
+		Weight:      2.4,
+		GameHinter: func(g *Game) (bool, Hint, error) {
+			if len(g.Cages) == 0 {
+				return false, Hint{}, nil
+			}
+			total := 0
+			for _, s := range g.Symbols {
+				n, err := g.symbolNumber(s)
+				if err != nil {
+					return false, Hint{}, err
+				}
+				total += n
+			}
+
+			for _, p := range g.partitions() {
+				for hi, house := range p.cells {
+					if len(house) != len(g.Symbols) {
+						continue
+					}
+					locs := []Loc{}
+					for _, lc := range house {
+						locs = append(locs, lc.Loc)
+					}
+
+					inside, touching := []int{}, []int{}
+					insideSum, touchingSum := 0, 0
+					for ci, c := range g.Cages {
+						in := 0
+						for _, l := range c.Cells {
+							if slices.Contains(locs, l) {
+								in++
+							}
+						}
+						if in == 0 {
+							continue
+						}
+						touching = append(touching, ci)
+						touchingSum += c.Sum
+						if in == len(c.Cells) {
+							inside = append(inside, ci)
+							insideSum += c.Sum
+						}
+					}
+
+					// Innie: the cells of the house outside of the cages inside it
+					innies := []LocCell{}
+					for _, lc := range house {
+						if !slices.Contains(inside, g.cageOf(lc.Loc)) {
+							innies = append(innies, lc)
+						}
+					}
+					if ok, h := g.cageDifference(innies, total-insideSum); ok {
+						h.Cage = &CageHint{Rule: "innie", Cages: inside, Sum: h.Cage.Sum, House: g.houseName(p.house, hi), Cells: g.cagesLocs(inside)}
+						h.Eliminator = name
+						h.Houses = []House{{Type: p.house, Index: hi}}
+						return true, h, nil
+					}
+
+					// Outie: the cells of the cages covering the house that are outside of it
+					if len(touching) == 0 || slices.ContainsFunc(locs, func(l Loc) bool { return !slices.Contains(touching, g.cageOf(l)) }) {
+						continue
+					}
+					outies := slices.DeleteFunc(g.cagesLocs(touching), func(l Loc) bool { return slices.Contains(locs, l) })
+					if ok, h := g.cageDifference(g.locCells(outies), touchingSum-total); ok {
+						h.Cage = &CageHint{Rule: "outie", Cages: touching, Sum: h.Cage.Sum, House: g.houseName(p.house, hi), Cells: g.cagesLocs(touching)}
+						h.Eliminator = name
+						h.Houses = []House{{Type: p.house, Index: hi}}
+						return true, h, nil
+					}
+				}
+			}
+			return false, Hint{}, nil
+		},
+	}
+
+	r.GameEliminator = GameHinterToEliminator(r.GameHinter)
+	return r
+}()
+
+// cageDifference finds the only empty cell among the cells when they add up to sum, and removes the candidates that
+// are not the value left over. The hint's Cage only has the value that is left.
+func (g *Game) cageDifference(cells []LocCell, sum int) (bool, Hint) {
+	var empty *LocCell
+	for i, lc := range cells {
+		if lc.Cell.Value == "" {
+			if empty != nil {
+				return false, Hint{}
+			}
+			empty = &cells[i]
+			continue
+		}
+		n, err := g.symbolNumber(lc.Cell.Value)
+		if err != nil {
+			return false, Hint{}
+		}
+		sum -= n
+	}
+	if empty == nil {
+		return false, Hint{}
+	}
+
+	remove := []string{}
+	for _, c := range empty.Cell.Candidates() {
+		if n, err := g.symbolNumber(c); err != nil || n != sum {
+			remove = append(remove, c)
+		}
+	}
+	if len(remove) == 0 || len(remove) == empty.Cell.CandidateCount() {
+		return false, Hint{} // Nothing to remove, or the cell has no room for the value and BadBoard will say so
+	}
+	return true, Hint{Loc: empty.Loc, CandidatesToRemove: remove, cell: empty.Cell, Cage: &CageHint{Sum: sum}}
+}
+
+// cagesLocs returns the cells of the cages.
+func (g *Game) cagesLocs(cages []int) []Loc {
+	locs := []Loc{}
+	for _, i := range cages {
+		locs = append(locs, g.Cages[i].Cells...)
+	}
+	return locs
+}
+
+var EliminatorCageRequired = func() CandidateEliminator {
+	name := "Cage Required Digit"
+	r := CandidateEliminator{
+		Name:        name,
+		Description: "When every combination of a killer cage uses a digit, one of the cage cells that can hold it must be it. Cells that see all of them cannot be the digit.",
+		Weight:      2.8,
+		GameHinter: func(g *Game) (bool, Hint, error) {
+			for i, cells := range g.cageCells() {
+				_, fills := g.cageOptions(cells, g.Cages[i].Sum)
+				if len(fills) == 0 {
+					continue
+				}
+				required := fills[0]
+				for _, f := range fills[1:] {
+					required &= f
+				}
+
+				for _, s := range required.Indexes() {
+					locs := []Loc{}
+					placed := false
+					for _, lc := range cells {
+						placed = placed || lc.Cell.valueSet().Has(s)
+						if lc.Cell.Value == "" && lc.Cell.candidates.Has(s) {
+							locs = append(locs, lc.Loc)
+						}
+					}
+					if placed || len(locs) == 0 {
+						continue
+					}
+
+					symbol := cells[0].Cell.index().symbol(s)
+					for _, lc := range g.CommonPeers(locs) {
+						if g.cageOf(lc.Loc) == i || !lc.Cell.HasCandidate(symbol) {
+							continue
+						}
+						return true, Hint{
+							Loc:                lc.Loc,
+							Eliminator:         name,
+							CandidatesToRemove: []string{symbol},
+							cell:               lc.Cell,
+							Cage:               &CageHint{Rule: "required", Cages: []int{i}, Sum: g.Cages[i].Sum, Symbol: symbol, Cells: locs},
+						}, nil
+					}
+				}
+			}
+			return false, Hint{}, nil
+		},
+	}
+
+	r.GameEliminator = GameHinterToEliminator(r.GameHinter)
+	return r
+}()
@@ -0,0 +1,152 @@
+package sudoku
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// killerGame returns an empty 9x9 killer game with the cages.
+func killerGame(t *testing.T, cages ...Cage) *Game {
+	t.Helper()
+	g := &Game{}
+	require.NoError(t, g.FillKiller(emptyCells(), cages))
+	return g
+}
+
+func TestCageCombinations(t *testing.T) {
+	g := killerGame(t, Cage{Sum: 3, Cells: []Loc{{X: 0, Y: 0}, {X: 1, Y: 0}}})
+
+	changes := collectChanges(t, g, EliminatorCageCombinations)
+	assert.Len(t, changes, 2)
+	assert.Equal(t, []string{"1", "2"}, g.Board[0][0].Cell.Candidates())
+	assert.Equal(t, []string{"1", "2"}, g.Board[0][1].Cell.Candidates())
+	assert.True(t, g.Sees(Loc{X: 0, Y: 0}, Loc{X: 1, Y: 0}))
+
+	g = killerGame(t, Cage{Sum: 10, Cells: []Loc{{X: 4, Y: 4}, {X: 6, Y: 6}}})
+	require.NoError(t, g.SetValue(4, 4, "3"))
+	ok, h, err := g.findHint([]CandidateEliminator{EliminatorCageCombinations})
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, Loc{X: 6, Y: 6}, h.Loc)
+	assert.Len(t, h.Targets[0].Candidates, 8)
+	require.NotNil(t, h.Cage)
+	assert.Equal(t, "because no combination for cage 0 adding up to 10 uses them", h.Cage.String())
+	require.Len(t, h.Houses, 1)
+	assert.Equal(t, "cage 0", h.Houses[0].Name)
+	assert.Equal(t, []Loc{{X: 4, Y: 4}, {X: 6, Y: 6}}, h.Houses[0].Cells)
+	assert.Equal(t, 9, g.Board[6][6].Cell.CandidateCount(), "the hint is not applied yet")
+}
+
+func TestInniesOuties(t *testing.T) {
+	// Row 0 is covered by cages except for its last cell, which must be 45 - 39 = 6.
+	innie := killerGame(t,
+		Cage{Sum: 17, Cells: []Loc{{X: 0, Y: 0}, {X: 1, Y: 0}}},
+		Cage{Sum: 15, Cells: []Loc{{X: 2, Y: 0}, {X: 3, Y: 0}, {X: 4, Y: 0}}},
+		Cage{Sum: 7, Cells: []Loc{{X: 5, Y: 0}, {X: 6, Y: 0}, {X: 7, Y: 0}}},
+	)
+	ok, h, err := innie.findHint([]CandidateEliminator{EliminatorInniesOuties})
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, Loc{X: 8, Y: 0}, h.Loc)
+	assert.NotContains(t, h.CandidatesToRemove, "6")
+	assert.Len(t, h.CandidatesToRemove, 8)
+	assert.Equal(t, "because the one cell of row 0 outside cages [0 1 2] must be 6", h.Cage.String())
+
+	// Cages covering row 0 stick out into row 1 by one cell, which must be 48 - 45 = 3.
+	outie := killerGame(t,
+		Cage{Sum: 20, Cells: []Loc{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 2, Y: 0}, {X: 3, Y: 0}}},
+		Cage{Sum: 28, Cells: []Loc{{X: 4, Y: 0}, {X: 5, Y: 0}, {X: 6, Y: 0}, {X: 7, Y: 0}, {X: 8, Y: 0}, {X: 8, Y: 1}}},
+	)
+	ok, h, err = outie.findHint([]CandidateEliminator{EliminatorInniesOuties})
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, Loc{X: 8, Y: 1}, h.Loc)
+	assert.NotContains(t, h.CandidatesToRemove, "3")
+	assert.Equal(t, "row 0", h.Houses[0].Name)
+	assert.Equal(t, "because the one cell of cages [0 1] outside row 0 must be 3", h.Cage.String())
+
+	assert.Equal(t, "because the one cell of row 0 must be 6", CageHint{Rule: "innie", House: "row 0", Sum: 6}.String())
+}
+
+func TestCageRequired(t *testing.T) {
+	// 1 + 2 + 4 is the only way to make 7 with three cells, so the rest of group 0 has none of them.
+	g := killerGame(t, Cage{Sum: 7, Cells: []Loc{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 0, Y: 1}}})
+	changes := collectChanges(t, g, EliminatorCageRequired)
+	assert.NotEmpty(t, changes)
+	for _, l := range []Loc{{X: 2, Y: 2}, {X: 1, Y: 1}} {
+		cell := g.Board[l.Y][l.X].Cell
+		for _, symbol := range []string{"1", "2", "4"} {
+			assert.False(t, cell.HasCandidate(symbol), "%v still has %s", l, symbol)
+		}
+	}
+	assert.True(t, g.Board[0][3].Cell.HasCandidate("1"), "only group 0 sees every cell of the cage")
+	assert.True(t, g.Board[0][5].Cell.HasCandidate("4"))
+}
+
+func TestCageBadBoard(t *testing.T) {
+	g := killerGame(t, Cage{Sum: 5, Cells: []Loc{{X: 0, Y: 0}, {X: 1, Y: 0}}})
+	require.NoError(t, g.SetValue(0, 0, "1"))
+	err := g.SetValue(0, 1, "3")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cage 0 cannot add up to 5")
+}
+
+func TestCheckCages(t *testing.T) {
+	tests := []struct {
+		name  string
+		cages []Cage
+	}{
+		{name: "outside of the board", cages: []Cage{{Sum: 3, Cells: []Loc{{X: 9, Y: 0}}}}},
+		{name: "shared cell", cages: []Cage{{Sum: 3, Cells: []Loc{{X: 0, Y: 0}}}, {Sum: 4, Cells: []Loc{{X: 0, Y: 0}}}}},
+		{name: "no cells", cages: []Cage{{Sum: 3}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &Game{}
+			assert.Error(t, g.FillKiller(emptyCells(), tt.cages))
+		})
+	}
+
+	g := &Game{Cages: []Cage{{Sum: 3, Cells: []Loc{{X: 0, Y: 0}}}}}
+	err := g.Fill([][]string{{"", ""}, {"", ""}}, map[Loc]int{{X: 0, Y: 0}: 0, {X: 1, Y: 0}: 0, {X: 0, Y: 1}: 1, {X: 1, Y: 1}: 1}, []string{"A", "B"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "symbol 'A' is not a number")
+}
+
+func TestKillerSolve(t *testing.T) {
+	solution, err := emptyGame(t).Solve()
+	require.NoError(t, err)
+
+	// Every row of every group becomes a cage of three cells
+	cages := []Cage{}
+	for y := 0; y < 9; y++ {
+		for x := 0; x < 9; x += 3 {
+			c := Cage{}
+			for i := x; i < x+3; i++ {
+				n, _ := strconv.Atoi(solution[y][i])
+				c.Sum += n
+				c.Cells = append(c.Cells, Loc{X: i, Y: y})
+			}
+			cages = append(cages, c)
+		}
+	}
+
+	g := killerGame(t, cages...)
+	found, err := g.Solve()
+	require.NoError(t, err)
+	for _, c := range cages {
+		sum := 0
+		for _, l := range c.Cells {
+			n, _ := strconv.Atoi(found[l.Y][l.X])
+			sum += n
+		}
+		assert.Equal(t, c.Sum, sum)
+	}
+
+	cages[0].Sum = 5 // Three different digits add up to at least 6
+	_, err = killerGame(t, cages...).Solve()
+	assert.ErrorIs(t, err, ErrNoSolution)
+}
@@ -1,6 +1,7 @@
 package sudoku
 
 import (
+	"errors"
 	"fmt"
 	"math/rand/v2"
 	"slices"
@@ -19,7 +20,11 @@ type CandidateEliminator struct {
 	Description         string
 	PartitionEliminator PartitionEliminator
 	GameEliminator      GameEliminator
-	Simple              bool // Allow hiding simple eliminators from the UI
+	Simple              bool    // Allow hiding simple eliminators from the UI
+	Uniqueness          bool    // Only valid when the puzzle has a single solution, see Game.AssumeUnique
+	Forcing             bool    // Tries candidates on a copy of the game, see Game.AllowForcing
+	Variant             string  // Only runs on games that declare the variant, see Game.Variants
+	Weight              float64 // How hard the eliminator is to spot, on a scale like the Sudoku Explainer ratings
 
 	PartitionHinter PartitionHinter
 	GameHinter      GameHinter
@@ -31,7 +36,54 @@ var Eliminators = []CandidateEliminator{
 	EliminatorUniqueCandidate,
 	EliminatorFistemafelRing,
 	EliminatorGroupAndRowColumn,
+	EliminatorCageCombinations,
+	EliminatorPalindrome,
+	EliminatorThermometer,
+	EliminatorGermanWhispers,
+	EliminatorArrow,
+	EliminatorRenban,
+	EliminatorRegionSum,
 	EliminatorCandidateChains,
+	EliminatorInniesOuties,
+	EliminatorCageRequired,
+	EliminatorXWing,
+	EliminatorSkyscraper,
+	EliminatorTwoStringKite,
+	EliminatorEmptyRectangle,
+	EliminatorSimpleColoring,
+	EliminatorUniqueRectangle,
+	EliminatorHiddenUniqueRectangle,
+	EliminatorBUG,
+	EliminatorXYWing,
+	EliminatorXYZWing,
+	EliminatorSwordfish,
+	EliminatorJellyfish,
+	EliminatorFinnedFish,
+	EliminatorMultiColoring,
+	EliminatorWXYZWing,
+	EliminatorSueDeCoq,
+	EliminatorXCycle,
+	EliminatorAIC,
+	EliminatorALSXZ,
+	EliminatorALSXYWing,
+	EliminatorDeathBlossom,
+	EliminatorNishio,
+	EliminatorCellForcing,
+	EliminatorUnitForcing,
+}
+
+// ErrNoEliminations is returned by EliminateCandidates when none of the eliminators can remove a candidate.
+var ErrNoEliminations = errors.New("no candidates eliminated by any rules")
+
+// eliminatorEnabled reports whether the game allows the eliminator to run.
+func (g *Game) eliminatorEnabled(e CandidateEliminator) bool {
+	return (!e.Uniqueness || (g.AssumeUnique && !g.hasExtraRules())) && (!e.Forcing || g.AllowForcing) && (e.Variant == "" || g.HasVariant(e.Variant))
+}
+
+// hasExtraRules reports whether the game has rules past its rows, columns and groups. Uniqueness patterns only look at
+// those, and swapping the values of a deadly pattern could break an extra house, cage, line or chess rule.
+func (g *Game) hasExtraRules() bool {
+	return len(g.Houses) > 0 || len(g.Lines) > 0 || g.hasExtraPeers()
 }
 
 func (g *Game) GetSectionedCells() (rows [][]LocCell, cols [][]LocCell, groups [][]LocCell) {
@@ -65,13 +117,51 @@ func (g *Game) GetSectionedCells() (rows [][]LocCell, cols [][]LocCell, groups [
 	return rows, cols, groups
 }
 
-func (g *Game) EliminateCandidates(onlySimples bool) (change string, _ error) {
+// partition is one kind of house the partition eliminators run over.
+type partition struct {
+	name  string // "rows", "cols", "groups" or "houses", as shown in eliminator changes
+	house string // House.Type of each of them
+	cells [][]LocCell
+}
+
+// partitions returns the rows, columns, groups and extra houses, in the order the partition eliminators run them.
+func (g *Game) partitions() []partition {
 	rows, cols, groups := g.GetSectionedCells()
+	return []partition{
+		{"rows", "row", rows},
+		{"cols", "column", cols},
+		{"groups", "group", groups},
+		{"houses", "extra", g.ExtraHouseCells()},
+	}
+}
 
+// houseName names one house of a partition for people, like "row 2" or "main diagonal".
+func (g *Game) houseName(house string, i int) string {
+	if house == "extra" {
+		return g.Houses[i].Name
+	}
+	if house == "line" {
+		return fmt.Sprintf("%s line %d", g.Lines[i].Type, i)
+	}
+	return fmt.Sprintf("%s %d", house, i)
+}
+
+func (g *Game) EliminateCandidates(onlySimples bool) (change string, _ error) {
+	err := g.Record("", func() error {
+		name, c, err := g.eliminateWith(Eliminators, onlySimples)
+		g.nameStep(name)
+		change = c
+		return err
+	})
+	return change, err
+}
+
+// eliminateWith runs the first of the eliminators that removes a candidate and returns its name with the change.
+func (g *Game) eliminateWith(eliminators []CandidateEliminator, onlySimples bool) (name, change string, _ error) {
 	if g.RandomEliminators {
 		// Shuffle the eliminators to randomize the order of elimination
 
-		slices.SortFunc(Eliminators, func(a, b CandidateEliminator) int {
+		slices.SortFunc(eliminators, func(a, b CandidateEliminator) int {
 			if a.Simple != b.Simple {
 				if a.Simple {
 					return -1 // Simple eliminators come first
@@ -81,19 +171,15 @@ func (g *Game) EliminateCandidates(onlySimples bool) (change string, _ error) {
 			return rand.IntN(2)*2 - 1 // Randomly order them if they are both simple or both not simple
 		})
 	}
-	for _, eliminator := range Eliminators {
+	for _, eliminator := range eliminators {
 		if onlySimples && !eliminator.Simple {
 			continue // Skip non-simple eliminators if onlySimples is true
 		}
+		if !g.eliminatorEnabled(eliminator) {
+			continue
+		}
 		if eliminator.PartitionEliminator != nil {
-			partitions := []struct {
-				name  string
-				cells [][]LocCell
-			}{
-				{"rows", rows},
-				{"cols", cols},
-				{"groups", groups},
-			}
+			partitions := g.partitions()
 
 			if g.RandomEliminators {
 				// Shuffle partitions to randomize the order of elimination
@@ -104,13 +190,13 @@ func (g *Game) EliminateCandidates(onlySimples bool) (change string, _ error) {
 				for i, cells := range ps.cells {
 					change, err := eliminator.PartitionEliminator(cells)
 					if err != nil {
-						return "", fmt.Errorf("(%s) %s %d: %w", eliminator.Name, ps.name, i, err)
+						return "", "", fmt.Errorf("(%s) %s %d: %w", eliminator.Name, ps.name, i, err)
 					}
 					if change != "" {
 						if g.HideSimple && eliminator.Simple {
-							return "", nil // Skip basic eliminators if HideBasic is true
+							return eliminator.Name, "", nil // Skip basic eliminators if HideBasic is true
 						}
-						return fmt.Sprintf("(%s) %s %d: %s", eliminator.Name, ps.name, i, change), nil
+						return eliminator.Name, fmt.Sprintf("(%s) %s %d: %s", eliminator.Name, ps.name, i, change), nil
 					}
 				}
 			}
@@ -118,12 +204,12 @@ func (g *Game) EliminateCandidates(onlySimples bool) (change string, _ error) {
 		if eliminator.GameEliminator != nil {
 			change, err := eliminator.GameEliminator(g)
 			if err != nil {
-				return "", fmt.Errorf("(%s): %w", eliminator.Name, err)
+				return "", "", fmt.Errorf("(%s): %w", eliminator.Name, err)
 			}
 			if change != "" {
-				return fmt.Sprintf("(%s): %s", eliminator.Name, change), nil
+				return eliminator.Name, fmt.Sprintf("(%s): %s", eliminator.Name, change), nil
 			}
 		}
 	}
-	return "", fmt.Errorf("no candidates eliminated by any rules")
+	return "", "", ErrNoEliminations
 }
@@ -0,0 +1,128 @@
+package sudoku
+
+import (
+	"fmt"
+	"math/bits"
+	"slices"
+	"sync"
+)
+
+// CandidateSet is a set of candidates as bits. Bit i stands for symbol i of the game, so a game can have up to 64 symbols.
+type CandidateSet uint64
+
+func (s CandidateSet) Has(i int) bool {
+	return s&(1<<i) != 0
+}
+
+func (s CandidateSet) With(i int) CandidateSet {
+	return s | 1<<i
+}
+
+func (s CandidateSet) Without(i int) CandidateSet {
+	return s &^ (1 << i)
+}
+
+func (s CandidateSet) Count() int {
+	return bits.OnesCount64(uint64(s))
+}
+
+// Indexes returns the symbol index of every candidate in the set, lowest first.
+func (s CandidateSet) Indexes() []int {
+	indexes := make([]int, 0, s.Count())
+	for ; s != 0; s &= s - 1 {
+		indexes = append(indexes, bits.TrailingZeros64(uint64(s)))
+	}
+	return indexes
+}
+
+// symbolIndex maps the symbols of a game to the bits of a CandidateSet.
+type symbolIndex struct {
+	symbols []string
+	bits    map[string]int
+
+	grows bool // Adds symbols the first time they are seen, used for cells that are not part of a game
+	mu    sync.RWMutex
+}
+
+func newSymbolIndex(symbols []string) (*symbolIndex, error) {
+	if len(symbols) > 64 {
+		return nil, fmt.Errorf("cannot index %d symbols, the most is 64", len(symbols))
+	}
+	x := &symbolIndex{symbols: symbols, bits: make(map[string]int, len(symbols))}
+	for i, s := range symbols {
+		x.bits[s] = i
+	}
+	return x, nil
+}
+
+// looseSymbols indexes the candidates of cells that were made outside of a game.
+var looseSymbols = &symbolIndex{bits: map[string]int{}, grows: true}
+
+// bit returns the index of a symbol. It is false for a symbol that is not part of the game.
+func (x *symbolIndex) bit(symbol string) (int, bool) {
+	if !x.grows {
+		i, ok := x.bits[symbol]
+		return i, ok
+	}
+
+	x.mu.RLock()
+	i, ok := x.bits[symbol]
+	x.mu.RUnlock()
+	if ok || symbol == "" {
+		return i, ok
+	}
+
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	if i, ok := x.bits[symbol]; ok {
+		return i, true
+	}
+	if len(x.symbols) == 64 {
+		return 0, false
+	}
+	x.bits[symbol] = len(x.symbols)
+	x.symbols = append(x.symbols, symbol)
+	return len(x.symbols) - 1, true
+}
+
+// set returns the symbols as a set, ignoring the ones that are not part of the game.
+func (x *symbolIndex) set(symbols []string) CandidateSet {
+	var s CandidateSet
+	for _, symbol := range symbols {
+		if i, ok := x.bit(symbol); ok {
+			s = s.With(i)
+		}
+	}
+	return s
+}
+
+func (x *symbolIndex) symbol(i int) string {
+	if x.grows {
+		x.mu.RLock()
+		defer x.mu.RUnlock()
+	}
+	return x.symbols[i]
+}
+
+// strings returns the symbols in the set in the order of the game, or sorted for cells outside of a game.
+func (x *symbolIndex) strings(s CandidateSet) []string {
+	if s == 0 {
+		return nil
+	}
+	symbols := make([]string, 0, s.Count())
+	for _, i := range s.Indexes() {
+		symbols = append(symbols, x.symbol(i))
+	}
+	if x.grows {
+		slices.Sort(symbols)
+	}
+	return symbols
+}
+
+// all returns every symbol of the game as a set.
+func (x *symbolIndex) all() CandidateSet {
+	if len(x.symbols) == 64 {
+		return ^CandidateSet(0)
+	}
+	return CandidateSet(1)<<len(x.symbols) - 1
+}
@@ -0,0 +1,73 @@
+package sudoku
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCandidateSet(t *testing.T) {
+	var s CandidateSet
+	s = s.With(0).With(3).With(63)
+	assert.True(t, s.Has(3))
+	assert.False(t, s.Has(1))
+	assert.Equal(t, 3, s.Count())
+	assert.Equal(t, []int{0, 3, 63}, s.Indexes())
+	assert.Equal(t, []int{0, 63}, s.Without(3).Indexes())
+}
+
+func TestCellCandidates(t *testing.T) {
+	g := emptyGame(t)
+	cell := g.Board[0][0].Cell
+	assert.Equal(t, g.Symbols, cell.Candidates())
+	assert.Equal(t, 9, cell.CandidateCount())
+
+	assert.Equal(t, []string{"2", "9"}, cell.RemoveCandiates([]string{"9", "", "2", "x"}))
+	assert.False(t, cell.HasCandidate("2"))
+	assert.True(t, cell.HasCandidate("3"))
+	assert.Equal(t, []string{"1", "3", "4", "5", "6", "7", "8"}, cell.Candidates())
+
+	cell.SetCandidates("5", "x", "1")
+	assert.Equal(t, []string{"1", "5"}, cell.Candidates(), "symbols outside of the game are dropped")
+	cell.Set("5")
+	assert.Nil(t, cell.Candidates())
+}
+
+func TestCellJSON(t *testing.T) {
+	g := emptyGame(t)
+	setCandidates(g, Loc{X: 0, Y: 0}, "3", "7")
+	b, err := json.Marshal(g.Board[0][0])
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"cell": {"value": "", "candidates": ["3", "7"], "isPreFilled": false, "isLastFilled": false, "recentCandidates": null}}`, string(b))
+
+	var decoded GroupedCell
+	require.NoError(t, json.Unmarshal(b, &decoded))
+	assert.Equal(t, []string{"3", "7"}, decoded.Cell.Candidates())
+	assert.True(t, decoded.Cell.HasCandidate("7"))
+}
+
+func TestFillSymbolLimit(t *testing.T) {
+	symbols := func(n int) []string {
+		s := []string{}
+		for i := range n {
+			s = append(s, strconv.Itoa(i))
+		}
+		return s
+	}
+	group := map[Loc]int{}
+	for i := range 65 {
+		group[Loc{X: i}] = i
+	}
+	cells := [][]string{make([]string, 65)}
+
+	g := &Game{}
+	assert.Error(t, g.Fill(cells, group, symbols(65)))
+
+	delete(group, Loc{X: 64})
+	cells = [][]string{make([]string, 64)}
+	require.NoError(t, g.Fill(cells, group, symbols(64)))
+	assert.Equal(t, 64, g.Board[0][0].Cell.CandidateCount())
+}
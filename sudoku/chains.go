@@ -0,0 +1,323 @@
+package sudoku
+
+import (
+	"fmt"
+	"slices"
+)
+
+// DefaultMaxChainLength is the most links a chain can have when Game.MaxChainLength is not set.
+const DefaultMaxChainLength = 12
+
+// ChainNode is a candidate in a chain along with the link to the next node.
+type ChainNode struct {
+	Loc    Loc    `json:"loc"`
+	Symbol string `json:"symbol"`
+	Link   string `json:"link,omitempty"` // "strong" or "weak", empty on the last node
+}
+
+// ChainHint is an ordered chain of candidates. Loops end on the node they started from.
+type ChainHint struct {
+	Name  string      `json:"name"`
+	Nodes []ChainNode `json:"nodes"`
+}
+
+func (c ChainHint) String() string {
+	s := "with " + c.Name
+	for _, n := range c.Nodes {
+		s += fmt.Sprintf(" %s %s", n.Symbol, formatLocs([]Loc{n.Loc}))
+		switch n.Link {
+		case "strong":
+			s += " ="
+		case "weak":
+			s += " -"
+		}
+	}
+	return s
+}
+
+func (c ChainHint) explain(g *Game) ([]Cause, []House) {
+	causes := []Cause{}
+	for _, n := range c.Nodes {
+		causes = mergeCauses(causes, Cause{Loc: n.Loc, Candidates: []string{n.Symbol}})
+	}
+	return causes, nil
+}
+
+var EliminatorXCycle = newChainEliminator(
+	"X-Cycle",
+	"Alternate strong and weak links on a single symbol. Cells seeing both ends of a chain cannot be the symbol, and loops turn their weak links strong.",
+	6.5,
+	true,
+)
+
+var EliminatorAIC = newChainEliminator(
+	"AIC",
+	"An Alternating Inference Chain links candidates across cells and symbols. One end of the chain must be true, so candidates that see both ends can be removed. Nice loops also remove candidates around the loop.",
+	7.0,
+	false,
+)
+
+func newChainEliminator(name, description string, weight float64, singleSymbol bool) CandidateEliminator {
+	r := CandidateEliminator{
+		Name:        name,
+		Description: description,
+		Weight:      weight,
+		GameHinter: func(g *Game) (bool, Hint, error) {
+			maxLength := g.MaxChainLength
+			if maxLength <= 0 {
+				maxLength = DefaultMaxChainLength
+			}
+
+			symbolSets := [][]string{g.Symbols}
+			if singleSymbol {
+				symbolSets = [][]string{}
+				for _, s := range g.Symbols {
+					symbolSets = append(symbolSets, []string{s})
+				}
+			}
+
+			var best *chainResult
+			for _, symbols := range symbolSets {
+				cg := newChainGraph(g, symbols, !singleSymbol)
+				for start := range cg.nodes {
+					res, ok := cg.search(start, maxLength)
+					if ok && (best == nil || len(res.path) < len(best.path)) {
+						best = &res
+					}
+				}
+			}
+			if best == nil {
+				return false, Hint{}, nil
+			}
+			return true, best.hint(name, singleSymbol), nil
+		},
+	}
+
+	r.GameEliminator = GameHinterToEliminator(r.GameHinter)
+	return r
+}
+
+// chainCandidate is a node of the chain graph, a candidate symbol in a cell.
+type chainCandidate struct {
+	loc    Loc
+	symbol string
+	cell   *Cell
+}
+
+// chainGraph holds the strong and weak links between candidates.
+type chainGraph struct {
+	g      *Game
+	nodes  []chainCandidate
+	strong [][]int
+	weak   [][]int
+}
+
+// newChainGraph links the candidates of the symbols. Strong links come from houses where a symbol has two cells,
+// and from cells with two candidates when cellLinks is set. Weak links join candidates that cannot both be true.
+func newChainGraph(g *Game, symbols []string, cellLinks bool) *chainGraph {
+	cg := &chainGraph{g: g}
+	index := map[Loc]map[string]int{}
+	for y := range g.Board {
+		for x := range g.Board[y] {
+			cell := g.Board[y][x].Cell
+			if cell.Value != "" {
+				continue
+			}
+			loc := Loc{X: x, Y: y}
+			for _, s := range symbols {
+				if !cell.HasCandidate(s) {
+					continue
+				}
+				if index[loc] == nil {
+					index[loc] = map[string]int{}
+				}
+				index[loc][s] = len(cg.nodes)
+				cg.nodes = append(cg.nodes, chainCandidate{loc: loc, symbol: s, cell: cell})
+			}
+		}
+	}
+	cg.strong = make([][]int, len(cg.nodes))
+	cg.weak = make([][]int, len(cg.nodes))
+
+	addStrong := func(a, b int) {
+		if slices.Contains(cg.strong[a], b) {
+			return
+		}
+		cg.strong[a] = append(cg.strong[a], b)
+		cg.strong[b] = append(cg.strong[b], a)
+	}
+
+	rows, cols, groups := g.GetSectionedCells()
+	for _, s := range symbols {
+		for _, link := range conjugatePairs(s, rows, cols, groups) {
+			addStrong(index[link.A][s], index[link.B][s])
+		}
+	}
+	if cellLinks {
+		for _, n := range cg.nodes {
+			if n.cell.CandidateCount() != 2 {
+				continue
+			}
+			pair := n.cell.Candidates()
+			a, okA := index[n.loc][pair[0]]
+			b, okB := index[n.loc][pair[1]]
+			if okA && okB {
+				addStrong(a, b)
+			}
+		}
+	}
+
+	for a := range cg.nodes {
+		for b := range cg.nodes {
+			if cg.weakLinked(a, b) {
+				cg.weak[a] = append(cg.weak[a], b)
+			}
+		}
+	}
+	return cg
+}
+
+// weakLinked reports whether two candidates cannot both be true.
+func (cg *chainGraph) weakLinked(a, b int) bool {
+	na, nb := cg.nodes[a], cg.nodes[b]
+	if na.loc == nb.loc {
+		return na.symbol != nb.symbol
+	}
+	return na.symbol == nb.symbol && cg.g.Sees(na.loc, nb.loc)
+}
+
+type chainResult struct {
+	graph  *chainGraph
+	path   []int // Alternates strong and weak links starting with a strong link
+	target int
+	kind   string // "chain", "discontinuous" or "continuous"
+}
+
+// search walks alternating chains from the start, assuming it is false, and returns the shortest one that removes a candidate.
+func (cg *chainGraph) search(start, maxLength int) (chainResult, bool) {
+	prev := make([]int, len(cg.nodes))
+	visited := make([]bool, len(cg.nodes))
+	visited[start] = true
+
+	path := func(end int) []int {
+		p := []int{end}
+		for end != start {
+			end = prev[end]
+			p = append(p, end)
+		}
+		slices.Reverse(p)
+		return p
+	}
+
+	type state struct {
+		node  int
+		on    bool
+		depth int
+	}
+	queue := []state{{node: start}}
+	for len(queue) > 0 {
+		s := queue[0]
+		queue = queue[1:]
+		if s.depth >= maxLength {
+			continue
+		}
+
+		if s.on {
+			for _, next := range cg.weak[s.node] {
+				if visited[next] {
+					continue
+				}
+				visited[next] = true
+				prev[next] = s.node
+				queue = append(queue, state{node: next, depth: s.depth + 1})
+			}
+			continue
+		}
+
+		for _, next := range cg.strong[s.node] {
+			if next == start && s.depth >= 2 && len(cg.weak[start]) > 0 {
+				// The start being false forces it to be true, so it is true
+				p := append(path(s.node), start)
+				return chainResult{graph: cg, path: p, target: cg.weak[start][0], kind: "discontinuous"}, true
+			}
+			if visited[next] {
+				continue
+			}
+			visited[next] = true
+			prev[next] = s.node
+			if s.depth+1 >= 3 {
+				if res, ok := cg.eliminate(path(next)); ok {
+					return res, true
+				}
+			}
+			queue = append(queue, state{node: next, on: true, depth: s.depth + 1})
+		}
+	}
+	return chainResult{}, false
+}
+
+// eliminate finds a candidate removed by a chain whose first node is false and last node is true.
+func (cg *chainGraph) eliminate(p []int) (chainResult, bool) {
+	first, last := p[0], p[len(p)-1]
+
+	// One of the ends is true, so anything weakly linked to both is false
+	for _, target := range cg.weak[first] {
+		if cg.weakLinked(target, last) {
+			return chainResult{graph: cg, path: p, target: target, kind: "chain"}, true
+		}
+	}
+
+	// Closing the loop with a weak link makes every weak link in the loop strong
+	if !cg.weakLinked(last, first) {
+		return chainResult{}, false
+	}
+	loop := append(slices.Clone(p), first)
+	for i := 1; i < len(loop)-1; i += 2 {
+		a, b := loop[i], loop[i+1]
+		for _, target := range cg.weak[a] {
+			if cg.weakLinked(target, b) && !slices.Contains(p, target) {
+				return chainResult{graph: cg, path: loop, target: target, kind: "continuous"}, true
+			}
+		}
+	}
+	return chainResult{}, false
+}
+
+func (r chainResult) hint(eliminator string, singleSymbol bool) Hint {
+	names := map[string]string{
+		"chain":         "AIC",
+		"discontinuous": "Discontinuous Nice Loop",
+		"continuous":    "Continuous Nice Loop",
+	}
+	if singleSymbol {
+		names = map[string]string{
+			"chain":         "X-Chain",
+			"discontinuous": "Discontinuous X-Cycle",
+			"continuous":    "Continuous X-Cycle",
+		}
+	}
+
+	nodes := make([]ChainNode, 0, len(r.path))
+	for i, n := range r.path {
+		node := ChainNode{Loc: r.graph.nodes[n].loc, Symbol: r.graph.nodes[n].symbol}
+		if i < len(r.path)-1 {
+			node.Link = "weak"
+			if i%2 == 0 {
+				node.Link = "strong"
+			}
+		}
+		nodes = append(nodes, node)
+	}
+
+	target := r.graph.nodes[r.target]
+	return Hint{
+		Loc:                target.loc,
+		CandidatesToRemove: []string{target.symbol},
+		Eliminator:         eliminator,
+		cell:               target.cell,
+		Chain: &ChainHint{
+			Name:  names[r.kind],
+			Nodes: nodes,
+		},
+	}
+}
@@ -0,0 +1,72 @@
+package sudoku
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEliminatorXCycle(t *testing.T) {
+	g := emptyGame(t)
+	keepSymbol(g, "5", []string{
+		"xxxxxxxxx",
+		".xxx.xxxx",
+		".xxx.xxxx",
+		".xxx.xxxx",
+		"x...x....",
+		".xxx.xxxx",
+		".xxx.xxxx",
+		".xxx.xxxx",
+		".xxx.xxxx",
+	})
+
+	ok, h, err := EliminatorXCycle.GameHinter(g)
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, "removed candidates (x:1,y:0) [5] with X-Chain 5 (x:0,y:0) = 5 (x:0,y:4) - 5 (x:4,y:4) = 5 (x:4,y:0)", h.String())
+	assert.Equal(t, []ChainNode{
+		{Loc: Loc{X: 0, Y: 0}, Symbol: "5", Link: "strong"},
+		{Loc: Loc{X: 0, Y: 4}, Symbol: "5", Link: "weak"},
+		{Loc: Loc{X: 4, Y: 4}, Symbol: "5", Link: "strong"},
+		{Loc: Loc{X: 4, Y: 0}, Symbol: "5"},
+	}, h.Chain.Nodes)
+
+	changes := collectChanges(t, g, EliminatorXCycle)
+	assert.Len(t, changes, 7)
+	require.NoError(t, g.BadBoard())
+}
+
+func TestEliminatorAIC(t *testing.T) {
+	newGame := func(t *testing.T) *Game {
+		g := emptyGame(t)
+		setCandidates(g, Loc{X: 0, Y: 0}, "1", "2")
+		setCandidates(g, Loc{X: 4, Y: 0}, "2", "3")
+		setCandidates(g, Loc{X: 4, Y: 4}, "1", "3")
+		return g
+	}
+
+	g := newGame(t)
+	ok, h, err := EliminatorAIC.GameHinter(g)
+	require.NoError(t, err)
+	require.True(t, ok)
+	t.Log(h.String())
+	assert.Equal(t, Loc{X: 0, Y: 4}, h.Loc)
+	assert.Equal(t, []string{"1"}, h.CandidatesToRemove)
+	assert.Equal(t, "AIC", h.Chain.Name)
+	assert.Len(t, h.Chain.Nodes, 6)
+
+	changes := collectChanges(t, g, EliminatorAIC)
+	assert.Equal(t, []string{
+		"removed candidates (x:0,y:4) [1] with AIC 1 (x:0,y:0) = 2 (x:0,y:0) - 2 (x:4,y:0) = 3 (x:4,y:0) - 3 (x:4,y:4) = 1 (x:4,y:4)",
+	}, changes)
+	require.NoError(t, g.BadBoard())
+
+	t.Run("max length", func(t *testing.T) {
+		g := newGame(t)
+		g.MaxChainLength = 3
+		ok, _, err := EliminatorAIC.GameHinter(g)
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,180 @@
+package sudoku
+
+import (
+	"fmt"
+	"slices"
+)
+
+var (
+	knightMoves = []Loc{{X: 1, Y: 2}, {X: 2, Y: 1}, {X: 2, Y: -1}, {X: 1, Y: -2}, {X: -1, Y: -2}, {X: -2, Y: -1}, {X: -2, Y: 1}, {X: -1, Y: 2}}
+	kingMoves   = []Loc{{X: 1, Y: 0}, {X: 1, Y: 1}, {X: 0, Y: 1}, {X: -1, Y: 1}, {X: -1, Y: 0}, {X: -1, Y: -1}, {X: 0, Y: -1}, {X: 1, Y: -1}}
+	orthogonal  = []Loc{{X: 1, Y: 0}, {X: 0, Y: 1}, {X: -1, Y: 0}, {X: 0, Y: -1}}
+)
+
+// moves returns the cells on the board one of the moves away from the location.
+func (g *Game) moves(l Loc, moves []Loc) []Loc {
+	locs := []Loc{}
+	for _, m := range moves {
+		to := Loc{X: l.X + m.X, Y: l.Y + m.Y}
+		if g.OnBoard(to) {
+			locs = append(locs, to)
+		}
+	}
+	return locs
+}
+
+// chessSees reports whether the anti-knight or anti-king rule keeps the cells from holding the same value.
+func (g *Game) chessSees(a, b Loc) bool {
+	d := Loc{X: b.X - a.X, Y: b.Y - a.Y}
+	return (g.AntiKnight && slices.Contains(knightMoves, d)) || (g.AntiKing && slices.Contains(kingMoves, d))
+}
+
+// hasExtraPeers reports whether cells can see each other other than through a row, column, group or extra house.
+func (g *Game) hasExtraPeers() bool {
+	return len(g.Cages) > 0 || g.AntiKnight || g.AntiKing || g.NonConsecutive ||
+		slices.ContainsFunc(g.Lines, func(l Line) bool { return l.distinct() })
+}
+
+// extraPeers returns the cells that see the location through a cage, a thermometer or renban line, or a chess move,
+// rather than through a row, column, group or extra house.
+func (g *Game) extraPeers(l Loc) []Loc {
+	peers := []Loc{}
+	if g.AntiKnight {
+		peers = append(peers, g.moves(l, knightMoves)...)
+	}
+	if g.AntiKing {
+		peers = append(peers, g.moves(l, kingMoves)...)
+	}
+	if i := g.cageOf(l); i >= 0 {
+		peers = append(peers, g.Cages[i].Cells...)
+	}
+	for _, line := range g.Lines {
+		if line.distinct() && slices.Contains(line.Cells, l) {
+			peers = append(peers, line.Cells...)
+		}
+	}
+	return slices.DeleteFunc(peers, func(p Loc) bool { return p == l })
+}
+
+// consecutive returns the symbols whose number is one more or one less than the symbol's.
+func (g *Game) consecutive(symbol string) []string {
+	n, err := g.symbolNumber(symbol)
+	if err != nil {
+		return nil
+	}
+	symbols := []string{}
+	for _, s := range g.Symbols {
+		if m, err := g.symbolNumber(s); err == nil && (m == n-1 || m == n+1) {
+			symbols = append(symbols, s)
+		}
+	}
+	return symbols
+}
+
+// peerHouses returns the cells that see the filled location other than through a partition, grouped by the cage, line
+// or rule that makes them see it. Chess and non-consecutive rules are houses of type "rule" named after the move.
+func (g *Game) peerHouses(filled Loc) []House {
+	houses := []House{}
+	from := formatLocs([]Loc{filled})
+	if g.AntiKnight {
+		houses = append(houses, House{Type: "rule", Name: "knight's moves from " + from, Cells: g.moves(filled, knightMoves)})
+	}
+	if g.AntiKing {
+		houses = append(houses, House{Type: "rule", Name: "king's moves from " + from, Cells: g.moves(filled, kingMoves)})
+	}
+	if i := g.cageOf(filled); i >= 0 {
+		houses = append(houses, House{Type: "cage", Index: i, Cells: g.Cages[i].Cells})
+	}
+	for i, line := range g.Lines {
+		if line.distinct() && slices.Contains(line.Cells, filled) {
+			houses = append(houses, House{Type: "line", Index: i, Cells: line.Cells})
+		}
+	}
+	return houses
+}
+
+// extraPeerHint removes the value of a filled cell from the candidates of the extra peers and, for non-consecutive
+// games, the values next to it from its orthogonal neighbors.
+func (g *Game) extraPeerHint() (bool, Hint, error) {
+	if !g.hasExtraPeers() {
+		return false, Hint{}, nil
+	}
+	for y := range g.Board {
+		for x := range g.Board[y] {
+			filled := Loc{X: x, Y: y}
+			value := g.Board[y][x].Cell.Value
+			if value == "" {
+				continue
+			}
+
+			for _, house := range g.peerHouses(filled) {
+				for _, p := range house.Cells {
+					if ok, h := g.removeFromPeer(filled, p, []string{value}, house); ok {
+						return true, h, nil
+					}
+				}
+			}
+			if !g.NonConsecutive {
+				continue
+			}
+			house := House{Type: "rule", Name: "neighbors of " + formatLocs([]Loc{filled}), Cells: g.moves(filled, orthogonal)}
+			for _, p := range house.Cells {
+				if ok, h := g.removeFromPeer(filled, p, g.consecutive(value), house); ok {
+					return true, h, nil
+				}
+			}
+		}
+	}
+	return false, Hint{}, nil
+}
+
+// removeFromPeer makes a hint that removes the symbols from the empty cell at p because of the filled cell, looking at
+// the house that makes p a peer of it.
+func (g *Game) removeFromPeer(filled, p Loc, symbols []string, house House) (bool, Hint) {
+	cell := g.Board[p.Y][p.X].Cell
+	if p == filled || cell.Value != "" {
+		return false, Hint{}
+	}
+	remove := slices.DeleteFunc(cell.Candidates(), func(s string) bool { return !slices.Contains(symbols, s) })
+	if len(remove) == 0 {
+		return false, Hint{}
+	}
+	return true, Hint{
+		Loc:                p,
+		CandidatesToRemove: remove,
+		cell:               cell,
+		Causes:             []Cause{{Loc: filled, Candidates: []string{g.Board[filled.Y][filled.X].Cell.Value}}},
+		Houses:             []House{house},
+	}
+}
+
+// checkExtraPeers returns an error when filled cells break a chess or non-consecutive rule, or repeat a value on a
+// thermometer or renban line. Cages are checked with their sums.
+func (g *Game) checkExtraPeers() error {
+	if !g.hasExtraPeers() {
+		return nil
+	}
+	for y := range g.Board {
+		for x := range g.Board[y] {
+			filled := Loc{X: x, Y: y}
+			value := g.Board[y][x].Cell.Value
+			if value == "" {
+				continue
+			}
+			for _, p := range g.extraPeers(filled) {
+				if g.Board[p.Y][p.X].Cell.Value == value {
+					return fmt.Errorf("duplicate value '%s' at positions %v", value, []Loc{filled, p})
+				}
+			}
+			if !g.NonConsecutive {
+				continue
+			}
+			for _, p := range g.moves(filled, orthogonal) {
+				if other := g.Board[p.Y][p.X].Cell.Value; slices.Contains(g.consecutive(value), other) {
+					return fmt.Errorf("consecutive values '%s' and '%s' at positions %v", value, other, []Loc{filled, p})
+				}
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package sudoku
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAntiKnight(t *testing.T) {
+	g := &Game{AntiKnight: true}
+	cells := emptyCells()
+	cells[0][0] = 1
+	require.NoError(t, g.FillBasic(cells))
+
+	assert.True(t, g.Sees(Loc{X: 0, Y: 0}, Loc{X: 1, Y: 2}))
+	assert.False(t, g.Sees(Loc{X: 0, Y: 0}, Loc{X: 3, Y: 3}))
+	assert.False(t, g.Board[2][1].Cell.HasCandidate("1"), "filling removes the value from knight's moves")
+	assert.False(t, g.Board[1][2].Cell.HasCandidate("1"))
+	assert.True(t, g.Board[3][3].Cell.HasCandidate("1"))
+
+	err := g.SetValue(2, 1, "1")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "duplicate value '1'")
+}
+
+func TestAntiKing(t *testing.T) {
+	g := &Game{AntiKing: true}
+	require.NoError(t, g.FillBasic(emptyCells()))
+	g.Board[4][4].Cell.Value = "7"
+
+	ok, h, err := EliminatorFilledCell.GameHinter(g)
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, Loc{X: 5, Y: 4}, h.Loc)
+	assert.Equal(t, []string{"7"}, h.CandidatesToRemove)
+	assert.Equal(t, []Cause{{Loc: Loc{X: 4, Y: 4}, Candidates: []string{"7"}}}, h.Causes)
+	h.Eliminator = EliminatorFilledCell.Name
+	assert.Equal(t, "Look for Filled Cell in king's moves from (x:4,y:4)", h.AtLevel(HintHouse).Message)
+	require.Len(t, h.Houses, 1)
+	assert.Len(t, h.Houses[0].Cells, 8)
+
+	require.NoError(t, g.RemoveAllSimple(true))
+	for _, l := range g.moves(Loc{X: 4, Y: 4}, kingMoves) {
+		assert.False(t, g.Board[l.Y][l.X].Cell.HasCandidate("7"), "%v still has 7", l)
+	}
+}
+
+func TestNonConsecutive(t *testing.T) {
+	g := &Game{NonConsecutive: true}
+	cells := emptyCells()
+	cells[4][4] = 5
+	require.NoError(t, g.FillBasic(cells))
+
+	assert.Equal(t, []string{"1", "2", "3", "7", "8", "9"}, g.Board[4][5].Cell.Candidates())
+	assert.True(t, g.Board[5][5].Cell.HasCandidate("4"), "diagonal neighbors can be consecutive")
+
+	g.Board[0][0].Cell.Value = "1"
+	ok, h, err := EliminatorFilledCell.GameHinter(g)
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, []string{"2"}, h.CandidatesToRemove)
+	assert.Equal(t, "neighbors of (x:0,y:0)", h.AtLevel(HintHouse).Hint.Houses[0].Name)
+
+	err = g.SetValue(3, 4, "6")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "consecutive values")
+}
+
+func TestJigsawSolve(t *testing.T) {
+	// Each band of three rows is split into three jagged regions
+	group := map[Loc]int{}
+	cells := make([][]string, 9)
+	for y := range 9 {
+		cells[y] = make([]string, 9)
+		for x := range 9 {
+			group[Loc{X: x, Y: y}] = y/3*3 + (x+y)%9/3
+		}
+	}
+
+	g := &Game{AntiKing: true}
+	require.NoError(t, g.Fill(cells, group, []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}))
+	assert.True(t, g.Sees(Loc{X: 2, Y: 1}, Loc{X: 1, Y: 2}), "cells in the same jagged region see each other")
+	solution, err := g.Solve()
+	require.NoError(t, err)
+
+	regions := map[int]map[string]bool{}
+	for y := range solution {
+		for x, v := range solution[y] {
+			l := Loc{X: x, Y: y}
+			if regions[group[l]] == nil {
+				regions[group[l]] = map[string]bool{}
+			}
+			assert.False(t, regions[group[l]][v], "region %d repeats %s", group[l], v)
+			regions[group[l]][v] = true
+			for _, m := range g.moves(l, kingMoves) {
+				assert.NotEqual(t, v, solution[m.Y][m.X], "%v and %v", l, m)
+			}
+		}
+	}
+
+	g = &Game{NonConsecutive: true}
+	require.NoError(t, g.Fill(cells, group, []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}))
+	solution, err = g.Solve()
+	require.NoError(t, err)
+	for y := range solution {
+		for x := range solution[y] {
+			for _, m := range g.moves(Loc{X: x, Y: y}, orthogonal) {
+				a, _ := strconv.Atoi(solution[y][x])
+				b, _ := strconv.Atoi(solution[m.Y][m.X])
+				assert.NotEqual(t, 1, abs(a-b), "%v and %v", Loc{X: x, Y: y}, m)
+			}
+		}
+	}
+}
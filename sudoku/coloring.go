@@ -0,0 +1,258 @@
+package sudoku
+
+import (
+	"fmt"
+	"slices"
+)
+
+// ColoredLoc is a cell in a coloring cluster. Cells of the same cluster and color are either all the symbol or none are.
+type ColoredLoc struct {
+	Loc     Loc `json:"loc"`
+	Cluster int `json:"cluster"`
+	Color   int `json:"color"` // 0 or 1
+}
+
+// ColoringHint describes the colored clusters of strong links used to remove a candidate.
+type ColoringHint struct {
+	Name   string       `json:"name"`
+	Rule   string       `json:"rule"` // "color wrap" or "color trap"
+	Symbol string       `json:"symbol"`
+	Cells  []ColoredLoc `json:"cells"`
+}
+
+func (c ColoringHint) String() string {
+	s := fmt.Sprintf("with %s %s on %s", c.Name, c.Rule, c.Symbol)
+	clusters := map[int][2][]Loc{}
+	order := []int{}
+	for _, cl := range c.Cells {
+		colors, ok := clusters[cl.Cluster]
+		if !ok {
+			order = append(order, cl.Cluster)
+		}
+		colors[cl.Color] = append(colors[cl.Color], cl.Loc)
+		clusters[cl.Cluster] = colors
+	}
+	for i, cluster := range order {
+		if i != 0 {
+			s += " and"
+		}
+		s += fmt.Sprintf(" colors [%s] [%s]", formatLocs(clusters[cluster][0]), formatLocs(clusters[cluster][1]))
+	}
+	return s
+}
+
+func (c ColoringHint) explain(g *Game) ([]Cause, []House) {
+	locs := []Loc{}
+	for _, cl := range c.Cells {
+		locs = append(locs, cl.Loc)
+	}
+	return g.causes(locs, c.Symbol), nil
+}
+
+// colorCluster is a group of cells connected by strong links on one symbol, split into two alternating colors.
+type colorCluster [2][]Loc
+
+// colorClusters connects strong links into clusters and gives each cell the opposite color of the cells it links to.
+func colorClusters(links []StrongLink) []colorCluster {
+	neighbors := map[Loc][]Loc{}
+	order := []Loc{}
+	for _, l := range links {
+		for _, pair := range [][2]Loc{{l.A, l.B}, {l.B, l.A}} {
+			if _, ok := neighbors[pair[0]]; !ok {
+				order = append(order, pair[0])
+			}
+			neighbors[pair[0]] = append(neighbors[pair[0]], pair[1])
+		}
+	}
+
+	colors := map[Loc]int{}
+	clusters := []colorCluster{}
+	for _, start := range order {
+		if _, ok := colors[start]; ok {
+			continue
+		}
+		cluster := colorCluster{}
+		colors[start] = 0
+		queue := []Loc{start}
+		for len(queue) > 0 {
+			loc := queue[0]
+			queue = queue[1:]
+			cluster[colors[loc]] = append(cluster[colors[loc]], loc)
+			for _, next := range neighbors[loc] {
+				if _, ok := colors[next]; ok {
+					continue
+				}
+				colors[next] = 1 - colors[loc]
+				queue = append(queue, next)
+			}
+		}
+		clusters = append(clusters, cluster)
+	}
+	return clusters
+}
+
+func (c colorCluster) contains(l Loc) bool {
+	return slices.Contains(c[0], l) || slices.Contains(c[1], l)
+}
+
+func (c colorCluster) coloredLocs(cluster int) []ColoredLoc {
+	cls := []ColoredLoc{}
+	for color, locs := range c {
+		for _, l := range locs {
+			cls = append(cls, ColoredLoc{Loc: l, Cluster: cluster, Color: color})
+		}
+	}
+	return cls
+}
+
+// seesAny reports whether the location sees at least one of the others.
+func (g *Game) seesAny(l Loc, others []Loc) bool {
+	return slices.ContainsFunc(others, func(o Loc) bool { return g.Sees(l, o) })
+}
+
+// colorWrap finds a color with two cells that see each other, so none of that color can be the symbol.
+func (g *Game) colorWrap(c colorCluster, symbol string) (Loc, bool) {
+	for _, locs := range c {
+		for _, l := range locs {
+			if !g.seesAny(l, locs) {
+				continue
+			}
+			for _, target := range locs {
+				if g.Board[target.Y][target.X].Cell.HasCandidate(symbol) {
+					return target, true
+				}
+			}
+		}
+	}
+	return Loc{}, false
+}
+
+// colorTrap finds a cell that sees one cell of each color, so it cannot be the symbol.
+func (g *Game) colorTrap(colorA, colorB []Loc, symbol string, skip func(Loc) bool) (Loc, bool) {
+	for y := range g.Board {
+		for x := range g.Board[y] {
+			l := Loc{X: x, Y: y}
+			if skip(l) || !g.Board[y][x].Cell.HasCandidate(symbol) {
+				continue
+			}
+			if g.seesAny(l, colorA) && g.seesAny(l, colorB) {
+				return l, true
+			}
+		}
+	}
+	return Loc{}, false
+}
+
+var EliminatorSimpleColoring = func() CandidateEliminator {
+	name := "Simple Coloring"
+	r := CandidateEliminator{
+		Name:        name,
+		Description: "Color the cells joined by strong links on a symbol in two alternating colors. If two cells of one color see each other that color is wrong, and cells seeing both colors cannot be the symbol.",
+		Weight:      4.3,
+		GameHinter: func(g *Game) (bool, Hint, error) {
+			rows, cols, groups := g.GetSectionedCells()
+			for _, symbol := range g.Symbols {
+				for _, cluster := range colorClusters(conjugatePairs(symbol, rows, cols, groups)) {
+					rule := "color wrap"
+					target, ok := g.colorWrap(cluster, symbol)
+					if !ok {
+						rule = "color trap"
+						target, ok = g.colorTrap(cluster[0], cluster[1], symbol, cluster.contains)
+					}
+					if !ok {
+						continue
+					}
+					return true, Hint{
+						Loc:                target,
+						CandidatesToRemove: []string{symbol},
+						Eliminator:         name,
+						cell:               g.Board[target.Y][target.X].Cell,
+						Coloring: &ColoringHint{
+							Name:   name,
+							Rule:   rule,
+							Symbol: symbol,
+							Cells:  cluster.coloredLocs(0),
+						},
+					}, nil
+				}
+			}
+			return false, Hint{}, nil
+		},
+	}
+
+	r.GameEliminator = GameHinterToEliminator(r.GameHinter)
+	return r
+}()
+
+var EliminatorMultiColoring = func() CandidateEliminator {
+	name := "Multi-Coloring"
+	r := CandidateEliminator{
+		Name:        name,
+		Description: "When a color of one cluster sees a color of another cluster, one of their opposite colors must be the symbol. A color that sees both colors of another cluster is wrong.",
+		Weight:      5.0,
+		GameHinter: func(g *Game) (bool, Hint, error) {
+			rows, cols, groups := g.GetSectionedCells()
+			for _, symbol := range g.Symbols {
+				clusters := colorClusters(conjugatePairs(symbol, rows, cols, groups))
+				for i, a := range clusters {
+					for j, b := range clusters {
+						if i == j {
+							continue
+						}
+						for ca := range a {
+							target, rule, ok := g.multiColor(a, b, ca, symbol)
+							if !ok {
+								continue
+							}
+							return true, Hint{
+								Loc:                target,
+								CandidatesToRemove: []string{symbol},
+								Eliminator:         name,
+								cell:               g.Board[target.Y][target.X].Cell,
+								Coloring: &ColoringHint{
+									Name:   name,
+									Rule:   rule,
+									Symbol: symbol,
+									Cells:  append(a.coloredLocs(0), b.coloredLocs(1)...),
+								},
+							}, nil
+						}
+					}
+				}
+			}
+			return false, Hint{}, nil
+		},
+	}
+
+	r.GameEliminator = GameHinterToEliminator(r.GameHinter)
+	return r
+}()
+
+// multiColor checks one color of cluster a against both colors of cluster b.
+func (g *Game) multiColor(a, b colorCluster, ca int, symbol string) (Loc, string, bool) {
+	seesB := [2]bool{}
+	for cb := range b {
+		seesB[cb] = slices.ContainsFunc(a[ca], func(l Loc) bool { return g.seesAny(l, b[cb]) })
+	}
+
+	// The color sees both colors of the other cluster so it must be wrong
+	if seesB[0] && seesB[1] {
+		for _, target := range a[ca] {
+			if g.Board[target.Y][target.X].Cell.HasCandidate(symbol) {
+				return target, "color wrap", true
+			}
+		}
+	}
+
+	// Either the opposite color of a or the opposite color of b must be right
+	for cb, sees := range seesB {
+		if !sees {
+			continue
+		}
+		target, ok := g.colorTrap(a[1-ca], b[1-cb], symbol, func(l Loc) bool { return false })
+		if ok {
+			return target, "color trap", true
+		}
+	}
+	return Loc{}, "", false
+}
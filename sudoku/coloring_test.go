@@ -0,0 +1,138 @@
+package sudoku
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEliminatorColoring(t *testing.T) {
+	tests := []struct {
+		name       string
+		eliminator CandidateEliminator
+		pattern    []string
+		expected   Hint
+	}{
+		{
+			name:       "color wrap",
+			eliminator: EliminatorSimpleColoring,
+			pattern: []string{
+				"x...x....",
+				"xxxx.xxxx",
+				"x.xx.xxxx",
+				"x.xx.xxxx",
+				".x..x....",
+				"x.xx.xxxx",
+				"x.xx.xxxx",
+				"x.xx.xxxx",
+				"x.xx.xxxx",
+			},
+			expected: Hint{
+				Loc:                Loc{X: 0, Y: 0},
+				CandidatesToRemove: []string{"5"},
+				Eliminator:         "Simple Coloring",
+				Coloring: &ColoringHint{
+					Name:   "Simple Coloring",
+					Rule:   "color wrap",
+					Symbol: "5",
+					Cells: []ColoredLoc{
+						{Loc: Loc{X: 0, Y: 0}, Color: 0},
+						{Loc: Loc{X: 4, Y: 4}, Color: 0},
+						{Loc: Loc{X: 1, Y: 1}, Color: 0},
+						{Loc: Loc{X: 4, Y: 0}, Color: 1},
+						{Loc: Loc{X: 1, Y: 4}, Color: 1},
+					},
+				},
+			},
+		},
+		{
+			name:       "color trap",
+			eliminator: EliminatorSimpleColoring,
+			pattern: []string{
+				"xxxxxxxxx",
+				".xxx.xxxx",
+				".xxx.xxxx",
+				".xxx.xxxx",
+				"x...x....",
+				".xxx.xxxx",
+				".xxx.xxxx",
+				".xxx.xxxx",
+				".xxx.xxxx",
+			},
+			expected: Hint{
+				Loc:                Loc{X: 1, Y: 0},
+				CandidatesToRemove: []string{"5"},
+				Eliminator:         "Simple Coloring",
+				Coloring: &ColoringHint{
+					Name:   "Simple Coloring",
+					Rule:   "color trap",
+					Symbol: "5",
+					Cells: []ColoredLoc{
+						{Loc: Loc{X: 0, Y: 4}, Color: 0},
+						{Loc: Loc{X: 4, Y: 0}, Color: 0},
+						{Loc: Loc{X: 4, Y: 4}, Color: 1},
+						{Loc: Loc{X: 0, Y: 0}, Color: 1},
+					},
+				},
+			},
+		},
+		{
+			name:       "multi-coloring",
+			eliminator: EliminatorMultiColoring,
+			pattern: []string{
+				"x...x....",
+				"xxxxxxxxx",
+				"x.xxxxxxx",
+				"x.xxxxxxx",
+				"x.xxxxxxx",
+				"x.xxxxxxx",
+				"xxxxxxxxx",
+				"x.xxxxxxx",
+				"x.xxxxxxx",
+			},
+			expected: Hint{
+				Loc:                Loc{X: 4, Y: 6},
+				CandidatesToRemove: []string{"5"},
+				Eliminator:         "Multi-Coloring",
+				Coloring: &ColoringHint{
+					Name:   "Multi-Coloring",
+					Rule:   "color trap",
+					Symbol: "5",
+					Cells: []ColoredLoc{
+						{Loc: Loc{X: 0, Y: 0}, Cluster: 0, Color: 0},
+						{Loc: Loc{X: 4, Y: 0}, Cluster: 0, Color: 1},
+						{Loc: Loc{X: 1, Y: 1}, Cluster: 1, Color: 0},
+						{Loc: Loc{X: 1, Y: 6}, Cluster: 1, Color: 1},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := emptyGame(t)
+			keepSymbol(g, "5", tt.pattern)
+
+			ok, h, err := tt.eliminator.GameHinter(g)
+			require.NoError(t, err)
+			require.True(t, ok)
+			t.Log(h.String())
+			h.cell = nil
+			assert.Equal(t, tt.expected, h)
+
+			collectChanges(t, g, tt.eliminator)
+			require.NoError(t, g.BadBoard())
+		})
+	}
+}
+
+func TestEliminatorSimpleColoringNoPattern(t *testing.T) {
+	g := emptyGame(t)
+	for _, e := range []CandidateEliminator{EliminatorSimpleColoring, EliminatorMultiColoring} {
+		ok, _, err := e.GameHinter(g)
+		require.NoError(t, err)
+		assert.False(t, ok, e.Name)
+	}
+}
@@ -0,0 +1,207 @@
+package sudoku
+
+import (
+	"cmp"
+	"math"
+	"slices"
+)
+
+// Rating scores a puzzle by the eliminators needed to solve it.
+type Rating struct {
+	Score    float64 `json:"score"`   // Weight of the hardest eliminator needed, like the Sudoku Explainer rating
+	Total    float64 `json:"total"`   // Sum of the weight of every step, like the HoDoKu score
+	Steps    int     `json:"steps"`   // Number of eliminations made
+	Hardest  string  `json:"hardest"` // Name of the hardest eliminator needed, or NakedSingle
+	Category string  `json:"category"`
+	Solved   bool    `json:"solved"` // False when the eliminators get stuck, the rating only covers the steps they made
+}
+
+// DifficultyCategories name the ranges of Rating.Score, from easiest to hardest.
+var DifficultyCategories = []struct {
+	Name     string
+	MaxScore float64
+}{
+	{"Easy", 1.5},
+	{"Medium", 2.6},
+	{"Hard", 4.0},
+	{"Expert", 6.0},
+	{"Extreme", math.Inf(1)},
+}
+
+// Unrated is the category of puzzles the eliminators cannot solve.
+const Unrated = "Unrated"
+
+// NakedSingle is the rating step of placing the only candidate of a cell when it is not also a hidden single. It is
+// not an eliminator, the rating counts it on its own with nakedSingleWeight like the Sudoku Explainer.
+const NakedSingle = "Naked Single"
+
+const nakedSingleWeight = 2.3
+
+// ratedStep is one elimination on the way to solving a game and how hard it was to spot.
+type ratedStep struct {
+	Name   string
+	Weight float64
+}
+
+// solvePath solves a copy of the game and returns the eliminator and weight of each step. Like the Sudoku Explainer,
+// each step is the easiest one on the board, so a hidden pair only counts when nothing simpler is left. Placing the
+// only candidate of a cell is free when it is a hidden single and counts as a NakedSingle otherwise.
+func (g *Game) solvePath() (steps []ratedStep, solved bool) {
+	eliminators := slices.Clone(Eliminators)
+	slices.SortStableFunc(eliminators, func(a, b CandidateEliminator) int { return cmp.Compare(a.Weight, b.Weight) })
+
+	c := g.clone()
+	steps = []ratedStep{}
+	for !c.Won() {
+		x, y, v, single := c.SingleCadidate()
+		hidden := single && c.hiddenSingle(Loc{X: x, Y: y}, v)
+		below := math.Inf(1)
+		if single && !hidden {
+			below = nakedSingleWeight // Only an easier hint goes before the naked single
+		}
+
+		ok, h, weight, err := c.easiestHint(eliminators, below)
+		if err != nil {
+			break
+		}
+		if single && (hidden || !ok) {
+			if !hidden {
+				steps = append(steps, ratedStep{Name: NakedSingle, Weight: nakedSingleWeight})
+			}
+			c.Board[y][x].Cell.Set(v)
+			continue
+		}
+		if !ok || h.apply() == 0 {
+			break
+		}
+		steps = append(steps, ratedStep{Name: h.Eliminator, Weight: weight})
+	}
+	return steps, c.Won() && c.BadBoard() == nil
+}
+
+// hiddenSingle reports whether one of the partitions of the location has no other place for the symbol.
+func (g *Game) hiddenSingle(l Loc, symbol string) bool {
+	for _, p := range g.partitions() {
+		for _, cells := range p.cells {
+			if !slices.ContainsFunc(cells, func(lc LocCell) bool { return lc.Loc == l }) {
+				continue
+			}
+			if !slices.ContainsFunc(cells, func(lc LocCell) bool {
+				return lc.Loc != l && (lc.Cell.Value == symbol || (lc.Cell.Value == "" && lc.Cell.HasCandidate(symbol)))
+			}) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// easiestHint returns the hint with the lowest weight under below, going through the eliminators from the lowest
+// weight up and stopping when none of the rest can find an easier one. A hint with its own weight, like a hidden pair,
+// counts for that instead of the weight of its eliminator.
+func (g *Game) easiestHint(eliminators []CandidateEliminator, below float64) (found bool, easiest Hint, weight float64, _ error) {
+	consider := func(e CandidateEliminator, h Hint) {
+		w := e.Weight
+		if h.Weight > 0 {
+			w = h.Weight
+		}
+		if w < below && (!found || w < weight) {
+			h.Eliminator = e.Name
+			found, easiest, weight = true, h, w
+		}
+	}
+
+	partitions := g.partitions()
+	for _, e := range eliminators {
+		if (found && weight <= e.Weight) || e.Weight >= below {
+			break
+		}
+		if !g.eliminatorEnabled(e) {
+			continue
+		}
+		if e.PartitionHinter != nil {
+			for _, ps := range partitions {
+				for _, cells := range ps.cells {
+					ok, h, err := e.PartitionHinter(cells)
+					if err != nil {
+						return false, Hint{}, 0, err
+					}
+					if ok {
+						consider(e, h)
+					}
+				}
+			}
+		}
+		if e.GameHinter != nil {
+			ok, h, err := e.GameHinter(g)
+			if err != nil {
+				return false, Hint{}, 0, err
+			}
+			if ok {
+				consider(e, h)
+			}
+		}
+	}
+	return found, easiest, weight, nil
+}
+
+// Rate solves a copy of the game with the eliminators and scores it by their weights.
+func (g *Game) Rate() Rating {
+	steps, solved := g.solvePath()
+	r := Rating{Steps: len(steps), Solved: solved, Category: Unrated}
+	for _, s := range steps {
+		r.Total += s.Weight
+		if s.Weight >= r.Score {
+			r.Score = s.Weight
+			r.Hardest = s.Name
+		}
+	}
+	r.Total = math.Round(r.Total*10) / 10
+
+	if solved {
+		for _, c := range DifficultyCategories {
+			if r.Score <= c.MaxScore {
+				r.Category = c.Name
+				break
+			}
+		}
+	}
+	return r
+}
+
+// SetRating rates the game and uses the category as its difficulty when it does not have one.
+func (g *Game) SetRating() {
+	r := g.Rate()
+	g.Rating = &r
+	if g.Difficulty == "" {
+		g.Difficulty = r.Category
+	}
+}
+
+// EliminatorsNeeded solves a copy of the game and returns the name of each eliminator that removed a candidate,
+// in the order of Eliminators. Solved is false when the eliminators get stuck.
+func (g *Game) EliminatorsNeeded() (names []string, solved bool) {
+	steps, solved := g.solvePath()
+	used := map[string]struct{}{}
+	for _, s := range steps {
+		used[s.Name] = struct{}{}
+	}
+
+	names = []string{}
+	for _, e := range Eliminators {
+		if _, ok := used[e.Name]; ok {
+			names = append(names, e.Name)
+		}
+	}
+	return names, solved
+}
+
+// HardestEliminator returns the name of the eliminator with the highest weight needed to solve the game, or
+// NakedSingle when placing a naked single is the hardest step. It is false when the eliminators cannot solve it.
+func (g *Game) HardestEliminator() (string, bool) {
+	r := g.Rate()
+	if !r.Solved {
+		return "", false
+	}
+	return r.Hardest, true
+}
@@ -0,0 +1,131 @@
+package sudoku
+
+import (
+	"math"
+	"testing"
+
+	"github.com/mvndaai/sudoku_hints/sudoku/boards"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// nakedSingleBoard needs a naked single that is not also a hidden single, but nothing harder.
+var nakedSingleBoard = [][]int{
+	{0, 0, 0, 0, 1, 0, 0, 0, 2},
+	{7, 0, 0, 0, 0, 0, 0, 6, 0},
+	{0, 4, 0, 0, 0, 0, 0, 1, 0},
+	{0, 8, 9, 0, 0, 0, 0, 0, 0},
+	{0, 0, 0, 0, 6, 0, 0, 0, 0},
+	{4, 1, 0, 0, 5, 0, 8, 0, 0},
+	{0, 0, 0, 5, 0, 3, 9, 0, 0},
+	{6, 0, 0, 0, 8, 1, 0, 2, 0},
+	{0, 7, 0, 0, 0, 4, 0, 0, 3},
+}
+
+func TestHardestEliminator(t *testing.T) {
+	tests := []struct {
+		name    string
+		board   [][]int
+		hardest string
+	}{
+		{name: "easy", board: boards.BasicEasy, hardest: "Unique Candidate"},
+		{name: "hard", board: boards.BasicHard, hardest: "Candidate Chains"},
+		{name: "pointing", board: boards.NYTHard7July2025, hardest: "Group and Row/Column"},
+		{name: "naked single", board: nakedSingleBoard, hardest: NakedSingle},
+		{name: "stuck", board: boards.ZeroBoard},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &Game{}
+			require.NoError(t, g.FillBasic(tt.board))
+			names, solved := g.EliminatorsNeeded()
+			assert.Equal(t, tt.hardest != "", solved)
+			if solved && tt.hardest != NakedSingle {
+				assert.Equal(t, tt.hardest, names[len(names)-1])
+			}
+			assert.NotContains(t, names, NakedSingle, "naked singles are not an eliminator")
+
+			hardest, ok := g.HardestEliminator()
+			assert.Equal(t, tt.hardest, hardest)
+			assert.Equal(t, solved, ok)
+			assert.False(t, g.Won(), "the game should not change")
+		})
+	}
+}
+
+func TestRate(t *testing.T) {
+	tests := []struct {
+		name     string
+		board    [][]int
+		score    float64
+		category string
+	}{
+		{name: "easy", board: boards.BasicEasy, score: 1.5, category: "Easy"},
+		{name: "hidden singles", board: boards.NYTHard17July2025, score: 1.5, category: "Easy"},
+		{name: "pointing", board: boards.NYTHard7July2025, score: 2.6, category: "Medium"},
+		{name: "naked subset", board: boards.NYTHard2June2025, score: 3.0, category: "Hard"},
+		{name: "stuck", board: boards.ZeroBoard, category: Unrated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &Game{}
+			require.NoError(t, g.FillBasic(tt.board))
+			r := g.Rate()
+			assert.Equal(t, tt.score, r.Score)
+			assert.Equal(t, tt.category, r.Category)
+			assert.Equal(t, tt.category != Unrated, r.Solved)
+			assert.GreaterOrEqual(t, r.Total, r.Score)
+
+			g.SetRating()
+			assert.Equal(t, tt.category, g.Difficulty)
+		})
+	}
+
+	g := &Game{Difficulty: "hard"}
+	require.NoError(t, g.FillBasic(boards.BasicEasy))
+	g.SetRating()
+	assert.Equal(t, "hard", g.Difficulty, "a difficulty from the puzzle source is kept")
+	assert.Equal(t, "Easy", g.Rating.Category)
+}
+
+func TestRateHardBoards(t *testing.T) {
+	for name, board := range map[string][][]int{
+		"basic hard": boards.BasicHard,
+		"nyt 7 july": boards.NYTHard7July2025,
+		"nyt 2 june": boards.NYTHard2June2025,
+		"master":     boards.SudokuDotComMasterA,
+	} {
+		t.Run(name, func(t *testing.T) {
+			g := &Game{}
+			require.NoError(t, g.FillBasic(board))
+			r := g.Rate()
+			assert.NotEqual(t, "Easy", r.Category, "%+v", r)
+		})
+	}
+}
+
+func TestHiddenSubsetWeight(t *testing.T) {
+	// 1 and 2 only fit in the first two cells of row 0, a hidden pair
+	g := emptyGame(t)
+	for x := 2; x < 9; x++ {
+		g.Board[0][x].Cell.RemoveCandiates([]string{"1", "2"})
+	}
+	unique := []CandidateEliminator{EliminatorUniqueCandidate}
+	ok, h, weight, err := g.easiestHint(unique, math.Inf(1))
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, "Unique Candidate", h.Eliminator)
+	assert.Equal(t, 3.4, h.Weight)
+	assert.Equal(t, 3.4, weight)
+
+	// A hidden single in the same row is easier, so it goes first
+	for x := 1; x < 9; x++ {
+		g.Board[1][x].Cell.RemoveCandiates([]string{"9"})
+	}
+	_, h, weight, err = g.easiestHint(unique, math.Inf(1))
+	require.NoError(t, err)
+	assert.Equal(t, Loc{X: 0, Y: 1}, h.Loc)
+	assert.Equal(t, 1.5, weight)
+}
@@ -3,9 +3,60 @@ package sudoku
 import (
 	"cmp"
 	"fmt"
+	"math/bits"
 	"slices"
 )
 
+// String describes the change a hint makes, including the pattern that justifies it.
+func (h Hint) String() string {
+	s := fmt.Sprintf("removed candidates (x:%d,y:%d) %v", h.Loc.X, h.Loc.Y, h.CandidatesToRemove)
+	for _, t := range h.Targets {
+		if t.Loc != h.Loc {
+			s += fmt.Sprintf(" and (x:%d,y:%d) %v", t.Loc.X, t.Loc.Y, t.Candidates)
+		}
+	}
+	if h.Fish != nil {
+		s += " " + h.Fish.String()
+	}
+	if h.Wing != nil {
+		s += " " + h.Wing.String()
+	}
+	if h.SingleDigit != nil {
+		s += " " + h.SingleDigit.String()
+	}
+	if h.Coloring != nil {
+		s += " " + h.Coloring.String()
+	}
+	if h.Chain != nil {
+		s += " " + h.Chain.String()
+	}
+	if h.Uniqueness != nil {
+		s += " " + h.Uniqueness.String()
+	}
+	if h.ALS != nil {
+		s += " " + h.ALS.String()
+	}
+	if h.SueDeCoq != nil {
+		s += " " + h.SueDeCoq.String()
+	}
+	if h.Forcing != nil {
+		s += " " + h.Forcing.String()
+	}
+	if h.CandidateChain != nil {
+		s += " " + h.CandidateChain.String()
+	}
+	if h.Ring != nil {
+		s += " " + h.Ring.String()
+	}
+	if h.Cage != nil {
+		s += " " + h.Cage.String()
+	}
+	if h.Line != nil {
+		s += " " + h.Line.String()
+	}
+	return s
+}
+
 func PartitionHinterToEliminator(bpe PartitionHinter) PartitionEliminator {
 	return func(cells []LocCell) (string, error) {
 		ok, h, err := bpe(cells)
@@ -15,8 +66,22 @@ func PartitionHinterToEliminator(bpe PartitionHinter) PartitionEliminator {
 		if !ok {
 			return "", nil
 		}
-		_ = h.cell.RemoveCandiates(h.CandidatesToRemove)
-		return fmt.Sprintf("removed candidates (x:%d,y:%d) %v", h.Loc.X, h.Loc.Y, h.CandidatesToRemove), nil
+		_ = h.apply()
+		return h.String(), nil
+	}
+}
+
+func GameHinterToEliminator(gh GameHinter) GameEliminator {
+	return func(g *Game) (string, error) {
+		ok, h, err := gh(g)
+		if err != nil {
+			return "", err
+		}
+		if !ok {
+			return "", nil
+		}
+		_ = h.apply()
+		return h.String(), nil
 	}
 }
 
@@ -24,29 +89,44 @@ var EliminatorFilledCell = func() CandidateEliminator {
 	name := "Filled Cell"
 	r := CandidateEliminator{
 		Name:        name,
-		Description: "Eliminates candidates in the same row as a filled cell.",
+		Description: "Eliminates the value of a filled cell from the cells that see it.",
+		Weight:      1.0,
 		PartitionHinter: func(cells []LocCell) (bool, Hint, error) {
-			found := []string{}
+			var found CandidateSet
 			for _, c := range cells {
-				found = append(found, c.Cell.Value)
+				found |= c.Cell.valueSet()
 			}
 			for _, lc := range cells {
-				diffs := lc.Cell.CandidateDiffs(found)
-				if len(diffs) > 0 {
+				if lc.Cell.Value != "" {
+					continue // Cell is already filled, nothing to remove
+				}
+				diffs := lc.Cell.candidates & found
+				if diffs != 0 {
+					causes := []Cause{}
+					for _, c := range cells {
+						if c.Cell.valueSet()&diffs != 0 {
+							causes = append(causes, Cause{Loc: c.Loc, Candidates: []string{c.Cell.Value}})
+						}
+					}
 					return true, Hint{
 						Loc:                lc.Loc,
 						Eliminator:         name,
-						CandidatesToRemove: diffs,
+						CandidatesToRemove: lc.Cell.index().strings(diffs),
 						cell:               lc.Cell,
+						Causes:             causes,
 					}, nil
 				}
 			}
 			return false, Hint{}, nil
 		},
+		GameHinter: func(g *Game) (bool, Hint, error) {
+			return g.extraPeerHint() // Cages, lines and chess rules reach past the partitions
+		},
 		Simple: true,
 	}
 
 	r.PartitionEliminator = PartitionHinterToEliminator(r.PartitionHinter)
+	r.GameEliminator = GameHinterToEliminator(r.GameHinter)
 	return r
 }()
 
@@ -63,261 +143,174 @@ func (l Locs) Key() string {
 	return fmt.Sprint(l)
 }
 
-// TODO expand as hidden pairs/triples
-var EliminatorUniqueCandidate = CandidateEliminator{
-	Name:        "Unique Candidate",
-	Description: "Eliminates all other candidates if a cell has a unique candidate in its partition.",
-	PartitionEliminator: func(cells []LocCell) (string, error) {
-		candidates := map[string]Locs{}
-		for _, c := range cells {
-			for _, candidate := range c.Cell.Candidates {
-				if _, exists := candidates[candidate]; !exists {
-					candidates[candidate] = []Loc{}
-				}
-				candidates[candidate] = append(candidates[candidate], c.Loc)
-			}
-		}
-
-		type uniqueCandidate struct {
-			candiates []string
-			locs      Locs
-		}
-		uniqueCandidatesBuilder := map[string]uniqueCandidate{}
-		for candidate, locs := range candidates {
-			key := locs.Key()
-			uc, exists := uniqueCandidatesBuilder[key]
-			if !exists {
-				uc = uniqueCandidate{candiates: []string{}, locs: locs}
-			}
-			uc.candiates = append(uc.candiates, candidate)
-			uniqueCandidatesBuilder[key] = uc
-		}
-
-		// Remove ones where len of candidates does not match the number of locations
-		for key, uc := range uniqueCandidatesBuilder {
-			if len(uc.candiates) != len(uc.locs) {
-				delete(uniqueCandidatesBuilder, key)
-				continue
-			}
-		}
+// hiddenSubsetWeights are the weights of a hidden single, pair, triple and quad, like the Sudoku Explainer ratings.
+// Bigger subsets use the last one.
+var hiddenSubsetWeights = []float64{1.5, 3.4, 4.0, 5.4}
 
-		if len(uniqueCandidatesBuilder) == 0 {
-			return "", nil // No unique candidates found
-		}
-
-		uniqueCandidates := map[Loc][]string{}
-		for _, uc := range uniqueCandidatesBuilder {
-			for _, loc := range uc.locs {
-				uniqueCandidates[loc] = uc.candiates
+// EliminatorUniqueCandidate finds hidden singles and the hidden pairs, triples and quads made of N candidates that
+// only fit in the same N cells. Each hint weighs as much as its subset.
+var EliminatorUniqueCandidate = func() CandidateEliminator {
+	name := "Unique Candidate"
+	r := CandidateEliminator{
+		Name:        name,
+		Description: "Eliminates all other candidates if a cell has a unique candidate in its partition.",
+		Weight:      1.5,
+		PartitionHinter: func(cells []LocCell) (bool, Hint, error) {
+			if len(cells) > 64 {
+				return false, Hint{}, nil // A partition holds one cell per symbol, so there are never more than 64
 			}
-		}
 
-		for _, lc := range cells {
-			ucs, ok := uniqueCandidates[lc.Loc]
-			if !ok {
-				continue // No unique candidate for this cell
-			}
-			toRemove := slices.DeleteFunc(slices.Clone(lc.Cell.Candidates), func(c string) bool {
-				return slices.Contains(ucs, c)
-			})
-			removed := lc.Cell.RemoveCandiates(toRemove)
-			if len(removed) > 0 {
-				return fmt.Sprintf("removed candidates (x:%d,y:%d) %v", lc.Loc.X, lc.Loc.Y, removed), nil
+			// Cells each candidate can go in, as bits of the index in cells
+			var where [64]uint64
+			var all CandidateSet
+			for i, c := range cells {
+				for _, s := range c.Cell.candidates.Indexes() {
+					where[s] |= 1 << i
+				}
+				all |= c.Cell.candidates
 			}
-		}
-		return "", nil
-	},
-	Simple: true,
-}
-
-var EliminatorGroupAndRowColumn = CandidateEliminator{
-	Name:        "Group and Row/Column",
-	Description: "If a group only has values in a row or column, then remove those candidates from the other cells in that row or column.",
-	GameEliminator: func(g *Game) (string, error) {
-		groupPlaceValues := map[int]map[string]map[int][]string{}
 
-		var rowKey, colKey = "row", "col"
-
-		for y, row := range g.Board {
-			for x, gc := range row {
-				for _, c := range gc.Cell.Candidates {
-					if groupPlaceValues[gc.group] == nil {
-						groupPlaceValues[gc.group] = map[string]map[int][]string{}
-					}
-					if groupPlaceValues[gc.group][colKey] == nil {
-						groupPlaceValues[gc.group][colKey] = map[int][]string{}
-					}
-					if _, exists := groupPlaceValues[gc.group][colKey][x]; !exists {
-						groupPlaceValues[gc.group][colKey][x] = []string{}
+			for _, lc := range cells {
+				for _, s := range lc.Cell.candidates.Indexes() {
+					// N candidates that can only go in the same N cells cannot leave room for anything else
+					var unique CandidateSet
+					for _, o := range all.Indexes() {
+						if where[o] == where[s] {
+							unique = unique.With(o)
+						}
 					}
-					groupPlaceValues[gc.group][colKey][x] = append(groupPlaceValues[gc.group][colKey][x], c)
-
-					if groupPlaceValues[gc.group][rowKey] == nil {
-						groupPlaceValues[gc.group][rowKey] = map[int][]string{}
+					if bits.OnesCount64(where[s]) != unique.Count() {
+						continue
 					}
-					if _, exists := groupPlaceValues[gc.group][rowKey][y]; !exists {
-						groupPlaceValues[gc.group][rowKey][y] = []string{}
+					toRemove := lc.Cell.candidates &^ unique
+					if toRemove == 0 {
+						continue
 					}
-					groupPlaceValues[gc.group][rowKey][y] = append(groupPlaceValues[gc.group][rowKey][y], c)
-
-					slices.Sort(groupPlaceValues[gc.group][colKey][x])
-					groupPlaceValues[gc.group][colKey][x] = slices.Compact(groupPlaceValues[gc.group][colKey][x])
-					slices.Sort(groupPlaceValues[gc.group][rowKey][y])
-					groupPlaceValues[gc.group][rowKey][y] = slices.Compact(groupPlaceValues[gc.group][rowKey][y])
-				}
-			}
-		}
 
-		// Check if any group only has a candidate in a single row or column
-		groupPlaceLocs := map[int]map[string]map[string][]int{}
-		for group, places := range groupPlaceValues {
-			for placeType, locs := range places {
-				for loc, candidates := range locs {
-					for _, c := range candidates {
-						if groupPlaceLocs[group] == nil {
-							groupPlaceLocs[group] = map[string]map[string][]int{}
+					symbols := lc.Cell.index().strings(unique)
+					causes := []Cause{}
+					for i, c := range cells {
+						if where[s]&(1<<i) != 0 {
+							causes = append(causes, Cause{Loc: c.Loc, Candidates: symbols})
 						}
-						if groupPlaceLocs[group][placeType] == nil {
-							groupPlaceLocs[group][placeType] = map[string][]int{}
-						}
-						if groupPlaceLocs[group][placeType][c] == nil {
-							groupPlaceLocs[group][placeType][c] = []int{}
-						}
-						groupPlaceLocs[group][placeType][c] = append(groupPlaceLocs[group][placeType][c], loc)
 					}
+					return true, Hint{
+						Loc:                lc.Loc,
+						Eliminator:         name,
+						Weight:             hiddenSubsetWeights[min(unique.Count(), len(hiddenSubsetWeights))-1],
+						CandidatesToRemove: lc.Cell.index().strings(toRemove),
+						cell:               lc.Cell,
+						Causes:             causes,
+					}, nil
 				}
 			}
-		}
+			return false, Hint{}, nil
+		},
+		Simple: true,
+	}
 
-		type holder struct {
-			group int
-			loc   int
-		}
-		rowsToRemove := map[holder][]string{}
-		colsToRemove := map[holder][]string{}
-
-		//holders := map[holder][]string{}
-		for group, places := range groupPlaceLocs {
-			for placeType, locs := range places {
-				for candiate, rows := range locs {
-					if len(rows) != 1 {
-						continue
-					}
-					holder := holder{group: group, loc: rows[0]}
-					if placeType == rowKey {
-						if _, exists := rowsToRemove[holder]; !exists {
-							rowsToRemove[holder] = []string{}
-						}
-						rowsToRemove[holder] = append(rowsToRemove[holder], candiate)
+	r.PartitionEliminator = PartitionHinterToEliminator(r.PartitionHinter)
+	return r
+}()
+
+var EliminatorGroupAndRowColumn = func() CandidateEliminator {
+	name := "Group and Row/Column"
+	r := CandidateEliminator{
+		Name:        name,
+		Description: "If a group only has values in a row or column, then remove those candidates from the other cells in that row or column.",
+		Weight:      2.6,
+		GameHinter: func(g *Game) (bool, Hint, error) {
+			for _, in := range g.Intersections() {
+				// Candidates the group can only place where it crosses the line
+				var pointing CandidateSet
+				for _, lc := range in.Cells {
+					pointing |= lc.Cell.candidates
+				}
+				for _, lc := range in.GroupRest {
+					pointing &^= lc.Cell.candidates
+				}
+				if pointing == 0 {
+					continue
+				}
+				for _, lc := range in.LineRest {
+					toRemove := lc.Cell.candidates & pointing
+					if toRemove == 0 {
 						continue
 					}
-					if placeType == colKey {
-						if _, exists := colsToRemove[holder]; !exists {
-							colsToRemove[holder] = []string{}
+					causes := []Cause{}
+					for _, c := range in.Cells {
+						if shared := c.Cell.candidates & toRemove; shared != 0 {
+							causes = append(causes, Cause{Loc: c.Loc, Candidates: c.Cell.index().strings(shared)})
 						}
-						colsToRemove[holder] = append(colsToRemove[holder], candiate)
-						continue
 					}
+					return true, Hint{
+						Loc:                lc.Loc,
+						Eliminator:         name,
+						CandidatesToRemove: lc.Cell.index().strings(toRemove),
+						cell:               lc.Cell,
+						Causes:             causes,
+						Houses:             []House{{Type: "group", Index: in.Group}, {Type: in.House, Index: in.Index}},
+					}, nil
 				}
 			}
-		}
-
-		if len(rowsToRemove) == 0 && len(colsToRemove) == 0 {
-			return "", nil // No candidates to remove
-		}
+			return false, Hint{}, nil
+		},
+	}
 
-		type candiateHolder struct {
-			group     int
-			loc       int
-			candiates []string
-		}
-		rowsToRemoveSlice := make([]candiateHolder, 0, len(rowsToRemove))
-		colsToRemoveSlice := make([]candiateHolder, 0, len(colsToRemove))
-		for h, c := range rowsToRemove {
+	r.GameEliminator = GameHinterToEliminator(r.GameHinter)
+	return r
+}()
 
-			rowsToRemoveSlice = append(rowsToRemoveSlice, candiateHolder{group: h.group, loc: h.loc, candiates: c})
-		}
-		for h, c := range colsToRemove {
+// CandidateChainHint describes N cells in a house that hold only N candidates between them.
+type CandidateChainHint struct {
+	Cells      []Loc    `json:"cells"`
+	Candidates []string `json:"candidates"`
+}
 
-			colsToRemoveSlice = append(colsToRemoveSlice, candiateHolder{group: h.group, loc: h.loc, candiates: c})
+func (c CandidateChainHint) String() string {
+	return fmt.Sprintf("from chain of size %d", len(c.Cells))
+}
 
-		}
+func (c CandidateChainHint) explain(g *Game) ([]Cause, []House) {
+	return g.causes(c.Cells, c.Candidates...), nil
+}
 
-		sortFunc := func(a, b candiateHolder) int {
-			if a.group != b.group {
-				return cmp.Compare(a.group, b.group)
-			}
-			if a.loc != b.loc {
-				return cmp.Compare(a.loc, b.loc)
-			}
-			return slices.Compare(a.candiates, b.candiates)
-		}
-		slices.SortFunc(rowsToRemoveSlice, sortFunc)
-		slices.SortFunc(colsToRemoveSlice, sortFunc)
-
-		for y, row := range g.Board {
-			for x, gc := range row {
-				i := slices.IndexFunc(rowsToRemoveSlice, func(h candiateHolder) bool {
-					return y == h.loc && gc.group != h.group
-				})
-				if i >= 0 {
-					removed := gc.Cell.RemoveCandiates(rowsToRemoveSlice[i].candiates)
-					if len(removed) > 0 {
-						return fmt.Sprintf("removed candidates (x:%d,y:%d) %v", x, y, removed), nil
-					}
-				}
-				i = slices.IndexFunc(colsToRemoveSlice, func(h candiateHolder) bool {
-					return x == h.loc && gc.group != h.group
-				})
-				if i >= 0 {
-					removed := gc.Cell.RemoveCandiates(colsToRemoveSlice[i].candiates)
-					if len(removed) > 0 {
-						return fmt.Sprintf("removed candidates (x:%d,y:%d) %v", x, y, removed), nil
-					}
+var EliminatorCandidateChains = func() CandidateEliminator {
+	name := "Candidate Chains"
+	r := CandidateEliminator{
+		Name:        name,
+		Description: "If N cells form a chain where they share exactly N candidates total, remove those candidates from other cells in the partition.",
+		Weight:      3.0,
+		PartitionHinter: func(cells []LocCell) (bool, Hint, error) {
+			// Get cells with candidates
+			candidateCells := []LocCell{}
+			for _, lc := range cells {
+				if lc.Cell.CandidateCount() >= 2 {
+					candidateCells = append(candidateCells, lc)
 				}
 			}
-		}
-		return "", nil
-	},
-}
 
-var EliminatorCandidateChains = CandidateEliminator{
-	Name:        "Candidate Chains",
-	Description: "If N cells form a chain where they share exactly N candidates total, remove those candidates from other cells in the partition.",
-	PartitionEliminator: func(cells []LocCell) (string, error) {
-		// Get cells with candidates
-		candidateCells := []LocCell{}
-		for _, lc := range cells {
-			if len(lc.Cell.Candidates) >= 2 {
-				candidateCells = append(candidateCells, lc)
-			}
-		}
+			// Try all possible chain sizes from 2 to the number of cells
+			for chainSize := 2; chainSize <= len(candidateCells); chainSize++ {
+				// Generate all combinations of cells of the given chain size
+				combinations := getCombinations(candidateCells, chainSize)
 
-		// Try all possible chain sizes from 2 to the number of cells
-		for chainSize := 2; chainSize <= len(candidateCells); chainSize++ {
-			// Generate all combinations of cells of the given chain size
-			combinations := getCombinations(candidateCells, chainSize)
-
-			for _, combo := range combinations {
-				// Collect all unique candidates from the cells in this combination
-				allCandidates := map[string]bool{}
-				for _, cell := range combo {
-					for _, c := range cell.Cell.Candidates {
-						allCandidates[c] = true
+				for _, combo := range combinations {
+					// Check if this forms a valid chain (N cells with N total candidates)
+					var union CandidateSet
+					for _, lc := range combo {
+						union |= lc.Cell.candidates
 					}
-				}
-
-				// Check if this forms a valid chain (N cells with N total candidates)
-				if len(allCandidates) == chainSize {
-					candidatesList := make([]string, 0, len(allCandidates))
-					for c := range allCandidates {
-						candidatesList = append(candidatesList, c)
+					if union.Count() != chainSize {
+						continue
 					}
 
 					// Create a map of locations in the chain for quick lookup
 					chainLocs := map[Loc]bool{}
+					chain := []Loc{}
 					for _, cell := range combo {
 						chainLocs[cell.Loc] = true
+						chain = append(chain, cell.Loc)
 					}
 
 					// Remove these candidates from all other cells
@@ -325,39 +318,47 @@ var EliminatorCandidateChains = CandidateEliminator{
 						if chainLocs[lc.Loc] {
 							continue
 						}
-						removed := lc.Cell.RemoveCandiates(candidatesList)
-						if len(removed) > 0 {
-							return fmt.Sprintf("removed candidates (x:%d,y:%d) %v from chain of size %d", lc.Loc.X, lc.Loc.Y, removed, chainSize), nil
+						if toRemove := lc.Cell.candidates & union; toRemove != 0 {
+							return true, Hint{
+								Loc:                lc.Loc,
+								Eliminator:         name,
+								CandidatesToRemove: lc.Cell.index().strings(toRemove),
+								cell:               lc.Cell,
+								CandidateChain:     &CandidateChainHint{Cells: chain, Candidates: lc.Cell.index().strings(union)},
+							}, nil
 						}
 					}
 				}
 			}
-		}
-		return "", nil
-	},
-}
+			return false, Hint{}, nil
+		},
+	}
+
+	r.PartitionEliminator = PartitionHinterToEliminator(r.PartitionHinter)
+	return r
+}()
 
 // Helper function to generate all combinations of a given size
-func getCombinations(cells []LocCell, size int) [][]LocCell {
+func getCombinations[T any](items []T, size int) [][]T {
 	if size == 0 {
-		return [][]LocCell{{}}
+		return [][]T{{}}
 	}
-	if len(cells) < size {
-		return [][]LocCell{}
+	if len(items) < size {
+		return [][]T{}
 	}
 
-	result := [][]LocCell{}
+	result := [][]T{}
 
 	// Include first element
-	for _, combo := range getCombinations(cells[1:], size-1) {
-		newCombo := make([]LocCell, len(combo)+1)
-		newCombo[0] = cells[0]
+	for _, combo := range getCombinations(items[1:], size-1) {
+		newCombo := make([]T, len(combo)+1)
+		newCombo[0] = items[0]
 		copy(newCombo[1:], combo)
 		result = append(result, newCombo)
 	}
 
 	// Exclude first element
-	result = append(result, getCombinations(cells[1:], size)...)
+	result = append(result, getCombinations(items[1:], size)...)
 
 	return result
 }
@@ -365,118 +366,156 @@ func getCombinations(cells []LocCell, size int) [][]LocCell {
 // EliminatorFistemafelRing enforces that certain groups must contain the same set of values
 // This is used for variant Sudoku where groups are "disjoint" - they must have matching values
 // https://www.tiktok.com/@brainfueltips/video/7565584522092268813
-var EliminatorFistemafelRing = CandidateEliminator{
-	Name:        "Fistemafel Ring",
-	Description: "The 16 digits that ring the center much match the corners.",
-	GameEliminator: func(g *Game) (string, error) {
-		// Define the specific cells for each matching group by their coordinates
-		matchingGroups := []struct {
-			name string
-			locs []Loc
-		}{
-			{
-				name: "corners",
-				locs: []Loc{
-					{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 7, Y: 0}, {X: 8, Y: 0},
-					{X: 0, Y: 1}, {X: 1, Y: 1}, {X: 7, Y: 1}, {X: 8, Y: 1},
-					{X: 0, Y: 7}, {X: 1, Y: 7}, {X: 7, Y: 7}, {X: 8, Y: 7},
-					{X: 0, Y: 8}, {X: 1, Y: 8}, {X: 7, Y: 8}, {X: 8, Y: 8},
+var EliminatorFistemafelRing = func() CandidateEliminator {
+	name := "Fistemafel Ring"
+	r := CandidateEliminator{
+		Name:        name,
+		Description: "The 16 digits that ring the center much match the corners.",
+		Weight:      2.0,
+		Variant:     VariantFistemafelRing,
+		GameHinter: func(g *Game) (bool, Hint, error) {
+			// Define the specific cells for each matching group by their coordinates
+			matchingGroups := []struct {
+				name string
+				locs []Loc
+			}{
+				{
+					name: "corners",
+					locs: []Loc{
+						{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 7, Y: 0}, {X: 8, Y: 0},
+						{X: 0, Y: 1}, {X: 1, Y: 1}, {X: 7, Y: 1}, {X: 8, Y: 1},
+						{X: 0, Y: 7}, {X: 1, Y: 7}, {X: 7, Y: 7}, {X: 8, Y: 7},
+						{X: 0, Y: 8}, {X: 1, Y: 8}, {X: 7, Y: 8}, {X: 8, Y: 8},
+					},
 				},
-			},
-			{
-				name: "ring",
-				locs: []Loc{
-					{X: 2, Y: 2}, {X: 3, Y: 2}, {X: 4, Y: 2}, {X: 5, Y: 2}, {X: 6, Y: 2},
-					{X: 2, Y: 3}, {X: 6, Y: 3},
-					{X: 2, Y: 4}, {X: 6, Y: 4},
-					{X: 2, Y: 5}, {X: 6, Y: 5},
-					{X: 2, Y: 6}, {X: 3, Y: 6}, {X: 4, Y: 6}, {X: 5, Y: 6}, {X: 6, Y: 6},
+				{
+					name: "ring",
+					locs: []Loc{
+						{X: 2, Y: 2}, {X: 3, Y: 2}, {X: 4, Y: 2}, {X: 5, Y: 2}, {X: 6, Y: 2},
+						{X: 2, Y: 3}, {X: 6, Y: 3},
+						{X: 2, Y: 4}, {X: 6, Y: 4},
+						{X: 2, Y: 5}, {X: 6, Y: 5},
+						{X: 2, Y: 6}, {X: 3, Y: 6}, {X: 4, Y: 6}, {X: 5, Y: 6}, {X: 6, Y: 6},
+					},
 				},
-			},
-		}
+			}
 
-		// Collect cells for each group
-		groupCells := make([][]LocCell, len(matchingGroups))
-		for i, group := range matchingGroups {
-			groupCells[i] = make([]LocCell, 0, len(group.locs))
-			for _, loc := range group.locs {
-				if loc.Y < len(g.Board) && loc.X < len(g.Board[loc.Y]) {
-					groupCells[i] = append(groupCells[i], LocCell{
-						Loc:  loc,
-						Cell: g.Board[loc.Y][loc.X].Cell,
-					})
+			// Collect cells for each group
+			groupCells := make([][]LocCell, len(matchingGroups))
+			for i, group := range matchingGroups {
+				groupCells[i] = make([]LocCell, 0, len(group.locs))
+				for _, loc := range group.locs {
+					if loc.Y < len(g.Board) && loc.X < len(g.Board[loc.Y]) {
+						groupCells[i] = append(groupCells[i], LocCell{
+							Loc:  loc,
+							Cell: g.Board[loc.Y][loc.X].Cell,
+						})
+					}
 				}
 			}
-		}
 
-		// Check which groups are complete
-		groupValues := make([]map[string]bool, len(groupCells))
-		groupComplete := make([]bool, len(groupCells))
+			// Check which groups are complete
+			groupValues := make([]map[string]bool, len(groupCells))
+			groupComplete := make([]bool, len(groupCells))
 
-		for i, cells := range groupCells {
-			groupValues[i] = make(map[string]bool)
-			filledCount := 0
+			for i, cells := range groupCells {
+				groupValues[i] = make(map[string]bool)
+				filledCount := 0
 
-			for _, cell := range cells {
-				if cell.Cell.Value != "" {
-					groupValues[i][cell.Cell.Value] = true
-					filledCount++
+				for _, cell := range cells {
+					if cell.Cell.Value != "" {
+						groupValues[i][cell.Cell.Value] = true
+						filledCount++
+					}
 				}
-			}
-
-			groupComplete[i] = (filledCount == len(cells))
-		}
-
-		// If exactly one group is complete, remove candidates from other groups that aren't in the complete group's values
-		completeGroupIdx := -1
-		completeCount := 0
 
-		for i, complete := range groupComplete {
-			if complete {
-				completeGroupIdx = i
-				completeCount++
+				groupComplete[i] = (filledCount == len(cells))
 			}
-		}
 
-		if completeCount != 1 {
-			return "", nil
-		}
+			// If exactly one group is complete, remove candidates from other groups that aren't in the complete group's values
+			completeGroupIdx := -1
+			completeCount := 0
 
-		// One group is complete - enforce its values on other groups
-		completeValues := groupValues[completeGroupIdx]
-		completeGroupName := matchingGroups[completeGroupIdx].name
+			for i, complete := range groupComplete {
+				if complete {
+					completeGroupIdx = i
+					completeCount++
+				}
+			}
 
-		for i, cells := range groupCells {
-			if i == completeGroupIdx {
-				continue // Skip the complete group
+			if completeCount != 1 {
+				return false, Hint{}, nil
 			}
 
-			groupName := matchingGroups[i].name
+			// One group is complete - enforce its values on other groups
+			completeValues := groupValues[completeGroupIdx]
+			completeGroupName := matchingGroups[completeGroupIdx].name
 
-			// Remove candidates that are not in the complete group's values
-			for _, cell := range cells {
-				if cell.Cell.Value != "" {
-					continue
+			for i, cells := range groupCells {
+				if i == completeGroupIdx {
+					continue // Skip the complete group
 				}
 
-				toRemove := []string{}
-				for _, candidate := range cell.Cell.Candidates {
-					if !completeValues[candidate] {
-						toRemove = append(toRemove, candidate)
+				groupName := matchingGroups[i].name
+
+				// Remove candidates that are not in the complete group's values
+				for _, cell := range cells {
+					if cell.Cell.Value != "" {
+						continue
 					}
-				}
 
-				if len(toRemove) == 0 {
-					continue
-				}
+					toRemove := []string{}
+					for _, candidate := range cell.Cell.Candidates() {
+						if !completeValues[candidate] {
+							toRemove = append(toRemove, candidate)
+						}
+					}
+
+					if len(toRemove) == 0 {
+						continue
+					}
 
-				removed := cell.Cell.RemoveCandiates(toRemove)
-				if len(removed) > 0 {
-					return fmt.Sprintf("removed %v from %s cell at (%d,%d) because %s is complete without these values", removed, groupName, cell.Loc.X, cell.Loc.Y, completeGroupName), nil
+					complete := []Loc{}
+					for _, lc := range groupCells[completeGroupIdx] {
+						complete = append(complete, lc.Loc)
+					}
+					return true, Hint{
+						Loc:                cell.Loc,
+						Eliminator:         name,
+						CandidatesToRemove: toRemove,
+						cell:               cell.Cell,
+						Ring:               &RingHint{Group: groupName, Complete: completeGroupName, Cells: complete},
+					}, nil
 				}
 			}
+
+			return false, Hint{}, nil
+		},
+	}
+
+	// Keep the change describing the ring the way it always has
+	r.GameEliminator = func(g *Game) (string, error) {
+		ok, h, err := r.GameHinter(g)
+		if err != nil || !ok {
+			return "", err
 		}
+		_ = h.apply()
+		return fmt.Sprintf("removed %v from %s cell at (%d,%d) because %s", h.CandidatesToRemove, h.Ring.Group, h.Loc.X, h.Loc.Y, h.Ring.String()), nil
+	}
+	return r
+}()
+
+// RingHint describes a Fistemafel group that is complete, so the matching group can only hold its values.
+type RingHint struct {
+	Group    string `json:"group"`    // The group candidates are removed from, "corners" or "ring"
+	Complete string `json:"complete"` // The group that is complete
+	Cells    []Loc  `json:"cells"`    // The cells of the complete group
+}
+
+func (r RingHint) String() string {
+	return fmt.Sprintf("%s is complete without these values", r.Complete)
+}
 
-		return "", nil
-	},
+func (r RingHint) explain(g *Game) ([]Cause, []House) {
+	return g.causes(r.Cells), nil
 }
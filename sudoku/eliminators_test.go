@@ -12,15 +12,15 @@ import (
 
 func TestEliminatorFilledCell(t *testing.T) {
 	cells := []LocCell{
-		{Loc: Loc{X: 0, Y: 0}, Cell: &Cell{Value: "1", Candidates: nil}},
-		{Loc: Loc{X: 1, Y: 0}, Cell: &Cell{Value: "2", Candidates: nil}},
-		{Loc: Loc{X: 2, Y: 0}, Cell: &Cell{Value: "3", Candidates: nil}},
-		{Loc: Loc{X: 3, Y: 0}, Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}},
-		{Loc: Loc{X: 4, Y: 0}, Cell: &Cell{Value: "5", Candidates: nil}},
-		{Loc: Loc{X: 5, Y: 0}, Cell: &Cell{Value: "6", Candidates: nil}},
-		{Loc: Loc{X: 6, Y: 0}, Cell: &Cell{Value: "7", Candidates: nil}},
-		{Loc: Loc{X: 7, Y: 0}, Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}},
-		{Loc: Loc{X: 8, Y: 0}, Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}},
+		{Loc: Loc{X: 0, Y: 0}, Cell: newCell("1")},
+		{Loc: Loc{X: 1, Y: 0}, Cell: newCell("2")},
+		{Loc: Loc{X: 2, Y: 0}, Cell: newCell("3")},
+		{Loc: Loc{X: 3, Y: 0}, Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9")},
+		{Loc: Loc{X: 4, Y: 0}, Cell: newCell("5")},
+		{Loc: Loc{X: 5, Y: 0}, Cell: newCell("6")},
+		{Loc: Loc{X: 6, Y: 0}, Cell: newCell("7")},
+		{Loc: Loc{X: 7, Y: 0}, Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9")},
+		{Loc: Loc{X: 8, Y: 0}, Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9")},
 	}
 
 	expectedChanges := []string{
@@ -43,15 +43,15 @@ func TestEliminatorFilledCell(t *testing.T) {
 
 func TestEliminatorEliminatorUniqueCandidate(t *testing.T) {
 	cells := []LocCell{
-		{Loc: Loc{X: 0, Y: 0}, Cell: &Cell{Value: "1", Candidates: nil}},
-		{Loc: Loc{X: 1, Y: 0}, Cell: &Cell{Value: "2", Candidates: nil}},
-		{Loc: Loc{X: 2, Y: 0}, Cell: &Cell{Value: "3", Candidates: nil}},
-		{Loc: Loc{X: 3, Y: 0}, Cell: &Cell{Value: "", Candidates: []string{"4", "5"}}},
-		{Loc: Loc{X: 4, Y: 0}, Cell: &Cell{Value: "", Candidates: []string{"4", "5"}}},
-		{Loc: Loc{X: 5, Y: 0}, Cell: &Cell{Value: "", Candidates: []string{"4", "5", "6"}}},
-		{Loc: Loc{X: 6, Y: 0}, Cell: &Cell{Value: "", Candidates: []string{"7"}}},
-		{Loc: Loc{X: 7, Y: 0}, Cell: &Cell{Value: "", Candidates: []string{"8", "9"}}},
-		{Loc: Loc{X: 8, Y: 0}, Cell: &Cell{Value: "", Candidates: []string{"8", "9"}}},
+		{Loc: Loc{X: 0, Y: 0}, Cell: newCell("1")},
+		{Loc: Loc{X: 1, Y: 0}, Cell: newCell("2")},
+		{Loc: Loc{X: 2, Y: 0}, Cell: newCell("3")},
+		{Loc: Loc{X: 3, Y: 0}, Cell: newCell("", "4", "5")},
+		{Loc: Loc{X: 4, Y: 0}, Cell: newCell("", "4", "5")},
+		{Loc: Loc{X: 5, Y: 0}, Cell: newCell("", "4", "5", "6")},
+		{Loc: Loc{X: 6, Y: 0}, Cell: newCell("", "7")},
+		{Loc: Loc{X: 7, Y: 0}, Cell: newCell("", "8", "9")},
+		{Loc: Loc{X: 8, Y: 0}, Cell: newCell("", "8", "9")},
 	}
 
 	expectedChanges := []string{ // Note these can come in any order
@@ -80,15 +80,15 @@ func TestEliminatorEliminatorUniqueCandidate(t *testing.T) {
 
 func TestEliminatorCandidateChains(t *testing.T) {
 	cells := []LocCell{
-		{Loc: Loc{X: 0, Y: 0}, Cell: &Cell{Value: "", Candidates: []string{"1", "2"}}},
-		{Loc: Loc{X: 1, Y: 0}, Cell: &Cell{Value: "", Candidates: []string{"1", "2"}}},
-		{Loc: Loc{X: 2, Y: 0}, Cell: &Cell{Value: "", Candidates: []string{"3", "4"}}},
-		{Loc: Loc{X: 3, Y: 0}, Cell: &Cell{Value: "", Candidates: []string{"3", "4"}}},
-		{Loc: Loc{X: 4, Y: 0}, Cell: &Cell{Value: "", Candidates: []string{"4", "5", "6", "7"}}},
-		{Loc: Loc{X: 5, Y: 0}, Cell: &Cell{Value: "", Candidates: []string{"5", "6", "7"}}},
-		{Loc: Loc{X: 6, Y: 0}, Cell: &Cell{Value: "", Candidates: []string{"5", "6", "7"}}},
-		{Loc: Loc{X: 7, Y: 0}, Cell: &Cell{Value: "", Candidates: []string{"2", "3", "4", "5", "6", "7", "8", "9"}}},
-		{Loc: Loc{X: 8, Y: 0}, Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}},
+		{Loc: Loc{X: 0, Y: 0}, Cell: newCell("", "1", "2")},
+		{Loc: Loc{X: 1, Y: 0}, Cell: newCell("", "1", "2")},
+		{Loc: Loc{X: 2, Y: 0}, Cell: newCell("", "3", "4")},
+		{Loc: Loc{X: 3, Y: 0}, Cell: newCell("", "3", "4")},
+		{Loc: Loc{X: 4, Y: 0}, Cell: newCell("", "4", "5", "6", "7")},
+		{Loc: Loc{X: 5, Y: 0}, Cell: newCell("", "5", "6", "7")},
+		{Loc: Loc{X: 6, Y: 0}, Cell: newCell("", "5", "6", "7")},
+		{Loc: Loc{X: 7, Y: 0}, Cell: newCell("", "2", "3", "4", "5", "6", "7", "8", "9")},
+		{Loc: Loc{X: 8, Y: 0}, Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9")},
 	}
 
 	expectedChanges := []string{ // Note these can come in any order
@@ -148,29 +148,35 @@ func TestEliminatorGroupAndRowColumn(t *testing.T) {
 				"removed candidates (x:6,y:2) [1 2 3]",
 				"removed candidates (x:7,y:2) [1 2 3]",
 				"removed candidates (x:8,y:2) [1 2 3]",
+				"removed candidates (x:6,y:2) [4 5 6]",
+				"removed candidates (x:7,y:2) [4 5 6]",
+				"removed candidates (x:8,y:2) [4 5 6]",
 			},
 		},
-		//{
-		//	name: "Columns",
-		//	board: [][]int{
-		//		{9, 6, 0, 0, 0, 0, 0, 0, 0},
-		//		{8, 5, 0, 0, 0, 0, 0, 0, 0},
-		//		{7, 4, 0, 0, 0, 0, 0, 0, 0},
+		{
+			name: "Columns",
+			board: [][]int{
+				{9, 6, 0, 0, 0, 0, 0, 0, 0},
+				{8, 5, 0, 0, 0, 0, 0, 0, 0},
+				{7, 4, 0, 0, 0, 0, 0, 0, 0},
 
-		//		{1, 0, 0, 0, 0, 0, 0, 0, 0},
-		//		{2, 0, 0, 0, 0, 0, 0, 0, 0},
-		//		{3, 0, 0, 0, 0, 0, 0, 0, 0},
+				{1, 0, 0, 0, 0, 0, 0, 0, 0},
+				{2, 0, 0, 0, 0, 0, 0, 0, 0},
+				{3, 0, 0, 0, 0, 0, 0, 0, 0},
 
-		//		{0, 0, 0, 0, 0, 0, 0, 0, 0},
-		//		{0, 0, 0, 0, 0, 0, 0, 0, 0},
-		//		{0, 0, 0, 0, 0, 0, 0, 0, 0},
-		//	},
-		//	expected: []string{
-		//		"removed candidates (x:2,y:6) [1 2 3]",
-		//		"removed candidates (x:2,y:7) [1 2 3]",
-		//		"removed candidates (x:2,y:8) [1 2 3]",
-		//	},
-		//},
+				{0, 0, 0, 0, 0, 0, 0, 0, 0},
+				{0, 0, 0, 0, 0, 0, 0, 0, 0},
+				{0, 0, 0, 0, 0, 0, 0, 0, 0},
+			},
+			expected: []string{
+				"removed candidates (x:2,y:6) [1 2 3]",
+				"removed candidates (x:2,y:7) [1 2 3]",
+				"removed candidates (x:2,y:8) [1 2 3]",
+				"removed candidates (x:2,y:6) [4 5 6]",
+				"removed candidates (x:2,y:7) [4 5 6]",
+				"removed candidates (x:2,y:8) [4 5 6]",
+			},
+		},
 		//{
 		//	name: "Hard",
 		//	board: [][]int{
@@ -211,11 +217,8 @@ func TestEliminatorGroupAndRowColumn(t *testing.T) {
 			g := &Game{}
 			err := g.FillBasic(tt.board)
 			require.NoError(t, err)
-			for {
-				if _, err := g.EliminateCandidates(true); err != nil {
-					break
-				}
-			}
+			// Only clear filled values, the other simple eliminators already cover some of these removals
+			require.NoError(t, g.RemoveAllSimple(true))
 
 			foundChanges := []string{}
 			for {
@@ -254,15 +257,15 @@ func TestEliminatorFistemafelRing(t *testing.T) {
 				// Create a board with corner group (0) complete with all 16 cells filled with unique values 1-8 (two of each)
 				// and ring group (1) with 9 as a candidate that should be removed
 				g.Board = [][]GroupedCell{
-					{{Cell: &Cell{Value: "1", IsPreFilled: true}, group: 0}, {Cell: &Cell{Value: "2", IsPreFilled: true}, group: 0}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 2}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 2}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 2}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 2}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 2}, {Cell: &Cell{Value: "3", IsPreFilled: true}, group: 0}, {Cell: &Cell{Value: "4", IsPreFilled: true}, group: 0}},
-					{{Cell: &Cell{Value: "5", IsPreFilled: true}, group: 0}, {Cell: &Cell{Value: "6", IsPreFilled: true}, group: 0}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 2}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 2}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 2}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 2}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 2}, {Cell: &Cell{Value: "7", IsPreFilled: true}, group: 0}, {Cell: &Cell{Value: "8", IsPreFilled: true}, group: 0}},
-					{{Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 2}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 2}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 1}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 1}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 1}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 1}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 1}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 2}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 2}},
-					{{Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 2}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 2}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 1}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 2}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 2}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 2}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 1}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 2}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 2}},
-					{{Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 2}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 2}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 1}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 2}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 2}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 2}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 1}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 2}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 2}},
-					{{Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 2}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 2}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 1}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 2}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 2}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 2}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 1}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 2}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 2}},
-					{{Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 2}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 2}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 1}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 1}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 1}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 1}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 1}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 2}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 2}},
-					{{Cell: &Cell{Value: "1", IsPreFilled: true}, group: 0}, {Cell: &Cell{Value: "2", IsPreFilled: true}, group: 0}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 2}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 2}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 2}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 2}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 2}, {Cell: &Cell{Value: "3", IsPreFilled: true}, group: 0}, {Cell: &Cell{Value: "4", IsPreFilled: true}, group: 0}},
-					{{Cell: &Cell{Value: "5", IsPreFilled: true}, group: 0}, {Cell: &Cell{Value: "6", IsPreFilled: true}, group: 0}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 2}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 2}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 2}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 2}, {Cell: &Cell{Value: "", Candidates: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}}, group: 2}, {Cell: &Cell{Value: "7", IsPreFilled: true}, group: 0}, {Cell: &Cell{Value: "8", IsPreFilled: true}, group: 0}},
+					{{Cell: &Cell{Value: "1", IsPreFilled: true}, group: 0}, {Cell: &Cell{Value: "2", IsPreFilled: true}, group: 0}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 2}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 2}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 2}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 2}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 2}, {Cell: &Cell{Value: "3", IsPreFilled: true}, group: 0}, {Cell: &Cell{Value: "4", IsPreFilled: true}, group: 0}},
+					{{Cell: &Cell{Value: "5", IsPreFilled: true}, group: 0}, {Cell: &Cell{Value: "6", IsPreFilled: true}, group: 0}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 2}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 2}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 2}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 2}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 2}, {Cell: &Cell{Value: "7", IsPreFilled: true}, group: 0}, {Cell: &Cell{Value: "8", IsPreFilled: true}, group: 0}},
+					{{Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 2}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 2}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 1}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 1}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 1}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 1}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 1}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 2}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 2}},
+					{{Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 2}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 2}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 1}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 2}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 2}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 2}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 1}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 2}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 2}},
+					{{Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 2}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 2}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 1}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 2}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 2}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 2}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 1}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 2}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 2}},
+					{{Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 2}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 2}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 1}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 2}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 2}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 2}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 1}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 2}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 2}},
+					{{Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 2}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 2}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 1}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 1}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 1}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 1}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 1}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 2}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 2}},
+					{{Cell: &Cell{Value: "1", IsPreFilled: true}, group: 0}, {Cell: &Cell{Value: "2", IsPreFilled: true}, group: 0}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 2}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 2}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 2}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 2}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 2}, {Cell: &Cell{Value: "3", IsPreFilled: true}, group: 0}, {Cell: &Cell{Value: "4", IsPreFilled: true}, group: 0}},
+					{{Cell: &Cell{Value: "5", IsPreFilled: true}, group: 0}, {Cell: &Cell{Value: "6", IsPreFilled: true}, group: 0}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 2}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 2}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 2}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 2}, {Cell: newCell("", "1", "2", "3", "4", "5", "6", "7", "8", "9"), group: 2}, {Cell: &Cell{Value: "7", IsPreFilled: true}, group: 0}, {Cell: &Cell{Value: "8", IsPreFilled: true}, group: 0}},
 				}
 				return g
 			},
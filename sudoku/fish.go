@@ -0,0 +1,317 @@
+package sudoku
+
+import (
+	"fmt"
+	"slices"
+)
+
+// FishHint describes the lines that make up a fish pattern.
+type FishHint struct {
+	Name       string `json:"name"`
+	Symbol     string `json:"symbol"`
+	BaseType   string `json:"baseType"` // "row" or "column"
+	BaseLines  []int  `json:"baseLines"`
+	CoverLines []int  `json:"coverLines"`
+	Fins       []Loc  `json:"fins,omitempty"` // Candidates outside the cover lines, all inside one group
+}
+
+func (f FishHint) String() string {
+	s := fmt.Sprintf("with %s on %ss %v covering %ss %v", f.Name, f.BaseType, f.BaseLines, f.coverType(), f.CoverLines)
+	if len(f.Fins) > 0 {
+		s += " and fins " + formatLocs(f.Fins)
+	}
+	return s
+}
+
+func (f FishHint) coverType() string {
+	if f.BaseType == "column" {
+		return "row"
+	}
+	return "column"
+}
+
+func (f FishHint) explain(g *Game) ([]Cause, []House) {
+	locs := []Loc{}
+	houses := []House{}
+	for _, line := range f.BaseLines {
+		houses = append(houses, House{Type: f.BaseType, Index: line})
+		for i := range g.Board {
+			l := Loc{X: i, Y: line}
+			if f.BaseType == "column" {
+				l = Loc{X: line, Y: i}
+			}
+			if g.Board[l.Y][l.X].Cell.HasCandidate(f.Symbol) {
+				locs = append(locs, l)
+			}
+		}
+	}
+	for _, line := range f.CoverLines {
+		houses = append(houses, House{Type: f.coverType(), Index: line})
+	}
+	return g.causes(slices.Concat(locs, f.Fins), f.Symbol), houses
+}
+
+var fishNames = map[int]string{
+	2: "X-Wing",
+	3: "Swordfish",
+	4: "Jellyfish",
+}
+
+var fishWeights = map[int]float64{
+	2: 3.2,
+	3: 3.8,
+	4: 5.2,
+}
+
+var (
+	EliminatorXWing     = newFishEliminator(2)
+	EliminatorSwordfish = newFishEliminator(3)
+	EliminatorJellyfish = newFishEliminator(4)
+)
+
+var EliminatorFinnedFish = func() CandidateEliminator {
+	name := "Finned Fish"
+	r := CandidateEliminator{
+		Name:        name,
+		Description: "A fish that only fails because of extra fin candidates inside one group. Cells in the cover lines that share the group with every fin can have the candidate removed.",
+		Weight:      4.0,
+		GameHinter: func(g *Game) (bool, Hint, error) {
+			rows, cols, _ := g.GetSectionedCells()
+			orientations := []fishOrientation{
+				{baseType: "row", base: rows, cover: cols},
+				{baseType: "column", base: cols, cover: rows},
+			}
+			for size := 2; size <= 4; size++ {
+				for _, symbol := range g.Symbols {
+					for _, o := range orientations {
+						if ok, h := o.findFinnedFish(g, symbol, size); ok {
+							h.Eliminator = name
+							return true, h, nil
+						}
+					}
+				}
+			}
+			return false, Hint{}, nil
+		},
+	}
+
+	r.GameEliminator = GameHinterToEliminator(r.GameHinter)
+	return r
+}()
+
+func newFishEliminator(size int) CandidateEliminator {
+	name := fishNames[size]
+	r := CandidateEliminator{
+		Name:        name,
+		Description: fmt.Sprintf("If a candidate in %d rows (or columns) only appears in the same %d columns (or rows), remove it from the rest of those columns (or rows).", size, size),
+		Weight:      fishWeights[size],
+		GameHinter: func(g *Game) (bool, Hint, error) {
+			rows, cols, _ := g.GetSectionedCells()
+			orientations := []fishOrientation{
+				{baseType: "row", base: rows, cover: cols},
+				{baseType: "column", base: cols, cover: rows},
+			}
+			for _, symbol := range g.Symbols {
+				for _, o := range orientations {
+					if ok, h := o.findFish(symbol, size); ok {
+						h.Eliminator = name
+						return true, h, nil
+					}
+				}
+			}
+			return false, Hint{}, nil
+		},
+	}
+
+	r.GameEliminator = GameHinterToEliminator(r.GameHinter)
+	return r
+}
+
+// fishOrientation holds the base lines a fish is searched in and the lines that cover them.
+type fishOrientation struct {
+	baseType string
+	base     [][]LocCell
+	cover    [][]LocCell
+}
+
+type fishLine struct {
+	index     int
+	positions []int // Indexes of the cover lines holding the symbol
+}
+
+// baseIndex returns which base line a location is on.
+func (o fishOrientation) baseIndex(l Loc) int {
+	if o.baseType == "column" {
+		return l.X
+	}
+	return l.Y
+}
+
+// coverIndex returns which cover line a location is on.
+func (o fishOrientation) coverIndex(l Loc) int {
+	if o.baseType == "column" {
+		return l.Y
+	}
+	return l.X
+}
+
+// loc returns the location where a base line and a cover line cross.
+func (o fishOrientation) loc(base, cover int) Loc {
+	if o.baseType == "column" {
+		return Loc{X: base, Y: cover}
+	}
+	return Loc{X: cover, Y: base}
+}
+
+// lines returns the base lines where the symbol is unplaced and has between min and max positions.
+func (o fishOrientation) lines(symbol string, min, max int) []fishLine {
+	lines := []fishLine{}
+	for i, cells := range o.base {
+		line := fishLine{index: i}
+		placed := false
+		for _, lc := range cells {
+			if lc.Cell.Value == symbol {
+				placed = true
+				break
+			}
+			if lc.Cell.HasCandidate(symbol) {
+				line.positions = append(line.positions, o.coverIndex(lc.Loc))
+			}
+		}
+		if placed || len(line.positions) < min || len(line.positions) > max {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+func (o fishOrientation) findFish(symbol string, size int) (bool, Hint) {
+	for _, combo := range getCombinations(o.lines(symbol, 2, size), size) {
+		baseLines := make([]int, 0, size)
+		coverLines := []int{}
+		for _, line := range combo {
+			baseLines = append(baseLines, line.index)
+			coverLines = append(coverLines, line.positions...)
+		}
+		slices.Sort(coverLines)
+		coverLines = slices.Compact(coverLines)
+		if len(coverLines) != size {
+			continue
+		}
+
+		for _, ci := range coverLines {
+			for _, lc := range o.cover[ci] {
+				if slices.Contains(baseLines, o.baseIndex(lc.Loc)) || !lc.Cell.HasCandidate(symbol) {
+					continue
+				}
+				return true, Hint{
+					Loc:                lc.Loc,
+					CandidatesToRemove: []string{symbol},
+					cell:               lc.Cell,
+					Fish: &FishHint{
+						Name:       fishNames[size],
+						Symbol:     symbol,
+						BaseType:   o.baseType,
+						BaseLines:  baseLines,
+						CoverLines: coverLines,
+					},
+				}
+			}
+		}
+	}
+	return false, Hint{}
+}
+
+// finSpan returns the most cover lines a single group crosses, which limits how many positions can be fins.
+func (o fishOrientation) finSpan(g *Game) int {
+	groupLines := map[int]map[int]struct{}{}
+	for _, cells := range o.base {
+		for _, lc := range cells {
+			group := g.groupOf(lc.Loc)
+			if groupLines[group] == nil {
+				groupLines[group] = map[int]struct{}{}
+			}
+			groupLines[group][o.coverIndex(lc.Loc)] = struct{}{}
+		}
+	}
+	span := 0
+	for _, lines := range groupLines {
+		span = max(span, len(lines))
+	}
+	return span
+}
+
+func (o fishOrientation) findFinnedFish(g *Game, symbol string, size int) (bool, Hint) {
+	maxPositions := size + o.finSpan(g)
+	for _, combo := range getCombinations(o.lines(symbol, 1, maxPositions), size) {
+		baseLines := make([]int, 0, size)
+		positions := []int{}
+		for _, line := range combo {
+			baseLines = append(baseLines, line.index)
+			positions = append(positions, line.positions...)
+		}
+		slices.Sort(positions)
+		positions = slices.Compact(positions)
+		if len(positions) <= size || len(positions) > maxPositions {
+			continue // Either a basic fish or too many fins to share a group
+		}
+
+		for _, coverLines := range getCombinations(positions, size) {
+			fins := []Loc{}
+			sashimi := false
+			covered := true
+			for _, line := range combo {
+				inCover := 0
+				for _, p := range line.positions {
+					if slices.Contains(coverLines, p) {
+						inCover++
+						continue
+					}
+					fins = append(fins, o.loc(line.index, p))
+				}
+				if inCover == 0 {
+					covered = false
+					break
+				}
+				if inCover == 1 {
+					sashimi = true
+				}
+			}
+			if !covered || len(fins) == 0 {
+				continue
+			}
+
+			finGroup := g.groupOf(fins[0])
+			if slices.ContainsFunc(fins, func(l Loc) bool { return g.groupOf(l) != finGroup }) {
+				continue
+			}
+
+			for _, ci := range coverLines {
+				for _, lc := range o.cover[ci] {
+					if slices.Contains(baseLines, o.baseIndex(lc.Loc)) || g.groupOf(lc.Loc) != finGroup || !lc.Cell.HasCandidate(symbol) {
+						continue
+					}
+					kind := "Finned"
+					if sashimi {
+						kind = "Sashimi"
+					}
+					return true, Hint{
+						Loc:                lc.Loc,
+						CandidatesToRemove: []string{symbol},
+						cell:               lc.Cell,
+						Fish: &FishHint{
+							Name:       kind + " " + fishNames[size],
+							Symbol:     symbol,
+							BaseType:   o.baseType,
+							BaseLines:  baseLines,
+							CoverLines: coverLines,
+							Fins:       fins,
+						},
+					}
+				}
+			}
+		}
+	}
+	return false, Hint{}
+}
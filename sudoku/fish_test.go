@@ -0,0 +1,215 @@
+package sudoku
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEliminatorFish(t *testing.T) {
+	tests := []struct {
+		name       string
+		eliminator CandidateEliminator
+		pattern    []string
+		expected   []string
+	}{
+		{
+			name:       "X-Wing rows",
+			eliminator: EliminatorXWing,
+			pattern: []string{
+				"xxxxxxxxx",
+				"..x...x..",
+				"xxxxxxxxx",
+				"xxxxxxxxx",
+				"xxxxxxxxx",
+				"..x...x..",
+				"xxxxxxxxx",
+				"xxxxxxxxx",
+				"xxxxxxxxx",
+			},
+			expected: []string{
+				"removed candidates (x:2,y:0) [5] with X-Wing on rows [1 5] covering columns [2 6]",
+				"removed candidates (x:2,y:2) [5] with X-Wing on rows [1 5] covering columns [2 6]",
+				"removed candidates (x:2,y:3) [5] with X-Wing on rows [1 5] covering columns [2 6]",
+				"removed candidates (x:2,y:4) [5] with X-Wing on rows [1 5] covering columns [2 6]",
+				"removed candidates (x:2,y:6) [5] with X-Wing on rows [1 5] covering columns [2 6]",
+				"removed candidates (x:2,y:7) [5] with X-Wing on rows [1 5] covering columns [2 6]",
+				"removed candidates (x:2,y:8) [5] with X-Wing on rows [1 5] covering columns [2 6]",
+				"removed candidates (x:6,y:0) [5] with X-Wing on rows [1 5] covering columns [2 6]",
+				"removed candidates (x:6,y:2) [5] with X-Wing on rows [1 5] covering columns [2 6]",
+				"removed candidates (x:6,y:3) [5] with X-Wing on rows [1 5] covering columns [2 6]",
+				"removed candidates (x:6,y:4) [5] with X-Wing on rows [1 5] covering columns [2 6]",
+				"removed candidates (x:6,y:6) [5] with X-Wing on rows [1 5] covering columns [2 6]",
+				"removed candidates (x:6,y:7) [5] with X-Wing on rows [1 5] covering columns [2 6]",
+				"removed candidates (x:6,y:8) [5] with X-Wing on rows [1 5] covering columns [2 6]",
+			},
+		},
+		{
+			name:       "Swordfish columns",
+			eliminator: EliminatorSwordfish,
+			pattern: []string{
+				"xxxxxxx.x",
+				"x.xx.xx.x",
+				"x.xx.xx.x",
+				"xxxx.xxxx",
+				"x.xx.xx.x",
+				"x.xx.xx.x",
+				"x.xx.xx.x",
+				"x.xx.xx.x",
+				"x.xxxxxxx",
+			},
+			expected: []string{
+				"removed candidates (x:0,y:0) [5] with Swordfish on columns [1 4 7] covering rows [0 3 8]",
+				"removed candidates (x:2,y:0) [5] with Swordfish on columns [1 4 7] covering rows [0 3 8]",
+				"removed candidates (x:3,y:0) [5] with Swordfish on columns [1 4 7] covering rows [0 3 8]",
+				"removed candidates (x:5,y:0) [5] with Swordfish on columns [1 4 7] covering rows [0 3 8]",
+				"removed candidates (x:6,y:0) [5] with Swordfish on columns [1 4 7] covering rows [0 3 8]",
+				"removed candidates (x:8,y:0) [5] with Swordfish on columns [1 4 7] covering rows [0 3 8]",
+				"removed candidates (x:0,y:3) [5] with Swordfish on columns [1 4 7] covering rows [0 3 8]",
+				"removed candidates (x:2,y:3) [5] with Swordfish on columns [1 4 7] covering rows [0 3 8]",
+				"removed candidates (x:3,y:3) [5] with Swordfish on columns [1 4 7] covering rows [0 3 8]",
+				"removed candidates (x:5,y:3) [5] with Swordfish on columns [1 4 7] covering rows [0 3 8]",
+				"removed candidates (x:6,y:3) [5] with Swordfish on columns [1 4 7] covering rows [0 3 8]",
+				"removed candidates (x:8,y:3) [5] with Swordfish on columns [1 4 7] covering rows [0 3 8]",
+				"removed candidates (x:0,y:8) [5] with Swordfish on columns [1 4 7] covering rows [0 3 8]",
+				"removed candidates (x:2,y:8) [5] with Swordfish on columns [1 4 7] covering rows [0 3 8]",
+				"removed candidates (x:3,y:8) [5] with Swordfish on columns [1 4 7] covering rows [0 3 8]",
+				"removed candidates (x:5,y:8) [5] with Swordfish on columns [1 4 7] covering rows [0 3 8]",
+				"removed candidates (x:6,y:8) [5] with Swordfish on columns [1 4 7] covering rows [0 3 8]",
+				"removed candidates (x:8,y:8) [5] with Swordfish on columns [1 4 7] covering rows [0 3 8]",
+			},
+		},
+		{
+			name:       "Jellyfish not found when lines have too many positions",
+			eliminator: EliminatorJellyfish,
+			pattern: []string{
+				"xxxxxxxxx",
+				"xxxxx....",
+				"xxxxxxxxx",
+				"xxxxxxxxx",
+				"xxxxx....",
+				"xxxxxxxxx",
+				"xxxxxxxxx",
+				"xxxxx....",
+				"xxxxx....",
+			},
+			expected: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := emptyGame(t)
+			keepSymbol(g, "5", tt.pattern)
+
+			assert.ElementsMatch(t, tt.expected, collectChanges(t, g, tt.eliminator))
+			require.NoError(t, g.BadBoard())
+		})
+	}
+}
+
+func TestEliminatorFishSolvesPuzzle(t *testing.T) {
+	// Gets stuck without an X-Wing
+	g := &Game{}
+	require.NoError(t, g.FillBasic([][]int{
+		{1, 0, 0, 0, 0, 0, 5, 6, 9},
+		{4, 9, 2, 0, 5, 6, 1, 0, 8},
+		{0, 5, 6, 1, 0, 9, 2, 4, 0},
+		{0, 0, 9, 6, 4, 0, 8, 0, 1},
+		{0, 6, 4, 0, 1, 0, 0, 0, 0},
+		{2, 1, 8, 0, 3, 5, 6, 0, 4},
+		{0, 4, 0, 5, 0, 0, 0, 1, 6},
+		{9, 0, 5, 0, 6, 1, 4, 0, 2},
+		{6, 2, 1, 0, 0, 0, 0, 0, 5},
+	}))
+
+	usedXWing := false
+	for !g.Won() {
+		if x, y, v, ok := g.SingleCadidate(); ok {
+			g.Board[y][x].Cell.Set(v)
+			require.NoError(t, g.RemoveAllSimple(true))
+			continue
+		}
+		change, err := g.EliminateCandidates(false)
+		require.NoError(t, err)
+		require.NoError(t, g.BadBoard())
+		if strings.HasPrefix(change, "(X-Wing)") {
+			usedXWing = true
+		}
+	}
+	assert.True(t, usedXWing)
+}
+
+func TestEliminatorFinnedFish(t *testing.T) {
+	tests := []struct {
+		name     string
+		pattern  []string
+		expected []string
+	}{
+		{
+			name: "Finned X-Wing",
+			pattern: []string{
+				"xxxxxxxxx",
+				"..x...xx.",
+				"xxxxxxxxx",
+				"xxxxxxxxx",
+				"xxxxxxxxx",
+				"..x...x..",
+				"xxxxxxxxx",
+				"xxxxxxxxx",
+				"xxxxxxxxx",
+			},
+			expected: []string{
+				"removed candidates (x:6,y:0) [5] with Finned X-Wing on rows [1 5] covering columns [2 6] and fins (x:7,y:1)",
+				"removed candidates (x:6,y:2) [5] with Finned X-Wing on rows [1 5] covering columns [2 6] and fins (x:7,y:1)",
+			},
+		},
+		{
+			name: "Sashimi X-Wing",
+			pattern: []string{
+				"xxxxxxxxx",
+				"..x....x.",
+				"xxxxxxxxx",
+				"xxxxxxxxx",
+				"xxxxxxxxx",
+				"..x...x..",
+				"xxxxxxxxx",
+				"xxxxxxxxx",
+				"xxxxxxxxx",
+			},
+			expected: []string{
+				"removed candidates (x:6,y:0) [5] with Sashimi X-Wing on rows [1 5] covering columns [2 6] and fins (x:7,y:1)",
+				"removed candidates (x:6,y:2) [5] with Sashimi X-Wing on rows [1 5] covering columns [2 6] and fins (x:7,y:1)",
+				"removed candidates (x:7,y:3) [5] with Sashimi X-Wing on rows [1 5] covering columns [2 7] and fins (x:6,y:5)",
+				"removed candidates (x:7,y:4) [5] with Sashimi X-Wing on rows [1 5] covering columns [2 7] and fins (x:6,y:5)",
+			},
+		},
+		{
+			name: "Fins in different groups",
+			pattern: []string{
+				"xxxxxxxxx",
+				"..x...x.x",
+				"xxxxxxxxx",
+				"xxxxxxxxx",
+				"xxxxxxxxx",
+				"x.x...x..",
+				"xxxxxxxxx",
+				"xxxxxxxxx",
+				"xxxxxxxxx",
+			},
+			expected: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := emptyGame(t)
+			keepSymbol(g, "5", tt.pattern)
+
+			assert.ElementsMatch(t, tt.expected, collectChanges(t, g, EliminatorFinnedFish))
+			require.NoError(t, g.BadBoard())
+		})
+	}
+}
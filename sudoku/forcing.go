@@ -0,0 +1,289 @@
+package sudoku
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// ForcingBranch is one assumed value and the steps the simple eliminators took from it.
+type ForcingBranch struct {
+	Loc           Loc      `json:"loc"`
+	Symbol        string   `json:"symbol"`
+	Trace         []string `json:"trace"`
+	Contradiction string   `json:"contradiction,omitempty"`
+}
+
+func (b ForcingBranch) String() string {
+	s := fmt.Sprintf("%s is %s", formatLocs([]Loc{b.Loc}), b.Symbol)
+	for _, step := range b.Trace {
+		s += ", " + step
+	}
+	if b.Contradiction != "" {
+		s += ", contradiction " + b.Contradiction
+	}
+	return s
+}
+
+// ForcingHint describes the assumptions that were tried and where each of them led.
+type ForcingHint struct {
+	Name     string          `json:"name"`
+	Branches []ForcingBranch `json:"branches"`
+}
+
+func (h ForcingHint) String() string {
+	s := "with " + h.Name
+	for i, b := range h.Branches {
+		if i != 0 {
+			s += ";"
+		}
+		s += " " + b.String()
+	}
+	return s
+}
+
+func (h ForcingHint) explain(g *Game) ([]Cause, []House) {
+	causes := []Cause{}
+	for _, b := range h.Branches {
+		causes = mergeCauses(causes, Cause{Loc: b.Loc, Candidates: []string{b.Symbol}})
+	}
+	return causes, nil
+}
+
+// propagationEliminators are the simple eliminators followed after an assumption.
+// They are listed here because Eliminators refers to the forcing eliminators.
+var propagationEliminators = []CandidateEliminator{
+	EliminatorFilledCell,
+	EliminatorUniqueCandidate,
+	EliminatorCageCombinations,
+}
+
+type assumption struct {
+	Loc    Loc
+	Symbol string
+}
+
+type trial struct {
+	game   *Game
+	branch ForcingBranch
+}
+
+// removes reports whether the trial has removed the candidate from the cell.
+func (t trial) removes(l Loc, symbol string) bool {
+	cell := t.game.Board[l.Y][l.X].Cell
+	if cell.Value != "" {
+		return cell.Value != symbol
+	}
+	return !cell.HasCandidate(symbol)
+}
+
+// contradiction returns why the board cannot be solved, including a symbol that has nowhere left to go in a house.
+func (g *Game) contradiction() error {
+	if err := g.BadBoard(); err != nil {
+		return err
+	}
+	for _, section := range g.partitions() {
+		for i, cells := range section.cells {
+			for _, symbol := range g.Symbols {
+				if !slices.ContainsFunc(cells, func(lc LocCell) bool { return lc.Cell.Value == symbol || lc.Cell.HasCandidate(symbol) }) {
+					return fmt.Errorf("no place for '%s' in %s", symbol, g.houseName(section.house, i))
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// propagate fills single candidates and runs the simple eliminators until they are stuck or done reports true.
+// It returns every step taken and the contradiction it ran into.
+func (g *Game) propagate(done func(*Game) bool) (trace []string, _ error) {
+	trace = []string{}
+	for {
+		if err := g.contradiction(); err != nil {
+			return trace, err
+		}
+		if done != nil && done(g) {
+			return trace, nil
+		}
+		if x, y, v, ok := g.SingleCadidate(); ok {
+			g.Board[y][x].Cell.Set(v)
+			trace = append(trace, fmt.Sprintf("set %s to %s", formatLocs([]Loc{{X: x, Y: y}}), v))
+			continue
+		}
+		_, change, err := g.eliminateWith(propagationEliminators, true)
+		if errors.Is(err, ErrNoEliminations) {
+			return trace, nil
+		}
+		if err != nil {
+			return trace, err
+		}
+		trace = append(trace, change)
+	}
+}
+
+// assume sets the symbol on a copy of the game and propagates it.
+func (g *Game) assume(a assumption, done func(*Game) bool) trial {
+	t := trial{game: g.clone(), branch: ForcingBranch{Loc: a.Loc, Symbol: a.Symbol}}
+	t.game.Board[a.Loc.Y][a.Loc.X].Cell.Set(a.Symbol)
+	trace, err := t.game.propagate(done)
+	t.branch.Trace = trace
+	if err != nil {
+		t.branch.Contradiction = err.Error()
+	}
+	return t
+}
+
+var EliminatorNishio = func() CandidateEliminator {
+	name := "Nishio"
+	r := CandidateEliminator{
+		Name:        name,
+		Description: "Assume a candidate is the value of its cell and follow the simple eliminators. If that leads to a contradiction the candidate is removed.",
+		Weight:      7.6,
+		GameHinter: func(g *Game) (bool, Hint, error) {
+			for y := range g.Board {
+				for x := range g.Board[y] {
+					cell := g.Board[y][x].Cell
+					for _, symbol := range cell.Candidates() {
+						t := g.assume(assumption{Loc: Loc{X: x, Y: y}, Symbol: symbol}, nil)
+						if t.branch.Contradiction == "" {
+							continue
+						}
+						return true, Hint{
+							Loc:                Loc{X: x, Y: y},
+							CandidatesToRemove: []string{symbol},
+							Eliminator:         name,
+							cell:               cell,
+							Forcing:            &ForcingHint{Name: name, Branches: []ForcingBranch{t.branch}},
+						}, nil
+					}
+				}
+			}
+			return false, Hint{}, nil
+		},
+		Forcing: true,
+	}
+
+	r.GameEliminator = GameHinterToEliminator(r.GameHinter)
+	return r
+}()
+
+var EliminatorCellForcing = func() CandidateEliminator {
+	name := "Cell Forcing Chain"
+	r := CandidateEliminator{
+		Name:        name,
+		Description: "Follow each candidate of a cell with the simple eliminators. One of them is the value, so a candidate every one of them removes is removed.",
+		Weight:      8.2,
+		GameHinter: func(g *Game) (bool, Hint, error) {
+			trials := map[assumption]trial{}
+			for y := range g.Board {
+				for x := range g.Board[y] {
+					cell := g.Board[y][x].Cell
+					if cell.CandidateCount() < 2 {
+						continue
+					}
+					assumptions := []assumption{}
+					for _, symbol := range cell.Candidates() {
+						assumptions = append(assumptions, assumption{Loc: Loc{X: x, Y: y}, Symbol: symbol})
+					}
+					ok, h, err := g.forcing(name, assumptions, trials)
+					if err != nil || ok {
+						return ok, h, err
+					}
+				}
+			}
+			return false, Hint{}, nil
+		},
+		Forcing: true,
+	}
+
+	r.GameEliminator = GameHinterToEliminator(r.GameHinter)
+	return r
+}()
+
+var EliminatorUnitForcing = func() CandidateEliminator {
+	name := "Unit Forcing Chain"
+	r := CandidateEliminator{
+		Name:        name,
+		Description: "Follow each place a symbol can go in a row, column or group with the simple eliminators. One of them holds the symbol, so a candidate every one of them removes is removed.",
+		Weight:      8.3,
+		GameHinter: func(g *Game) (bool, Hint, error) {
+			trials := map[assumption]trial{}
+			rows, cols, groups := g.GetSectionedCells()
+			for _, cells := range slices.Concat(rows, cols, groups, g.ExtraHouseCells()) {
+				for _, symbol := range g.Symbols {
+					assumptions := []assumption{}
+					for _, lc := range cells {
+						if lc.Cell.HasCandidate(symbol) {
+							assumptions = append(assumptions, assumption{Loc: lc.Loc, Symbol: symbol})
+						}
+					}
+					if len(assumptions) < 2 {
+						continue
+					}
+					ok, h, err := g.forcing(name, assumptions, trials)
+					if err != nil || ok {
+						return ok, h, err
+					}
+				}
+			}
+			return false, Hint{}, nil
+		},
+		Forcing: true,
+	}
+
+	r.GameEliminator = GameHinterToEliminator(r.GameHinter)
+	return r
+}()
+
+// forcing follows a set of assumptions where one must be true. The ones that lead to a contradiction are false,
+// so a candidate removed by all the others can be removed from the game. Trials are shared between calls.
+func (g *Game) forcing(name string, assumptions []assumption, trials map[assumption]trial) (bool, Hint, error) {
+	possible := []trial{}
+	for _, a := range assumptions {
+		t, ok := trials[a]
+		if !ok {
+			t = g.assume(a, nil)
+			trials[a] = t
+		}
+		if t.branch.Contradiction == "" {
+			possible = append(possible, t)
+		}
+	}
+	if len(possible) == 0 {
+		return false, Hint{}, fmt.Errorf("every assumption leads to a contradiction: %v", assumptions)
+	}
+
+	for y := range g.Board {
+		for x := range g.Board[y] {
+			l := Loc{X: x, Y: y}
+			cell := g.Board[y][x].Cell
+			remove := slices.DeleteFunc(cell.Candidates(), func(c string) bool {
+				return slices.ContainsFunc(possible, func(t trial) bool { return !t.removes(l, c) })
+			})
+			if len(remove) == 0 {
+				continue
+			}
+
+			// Follow each possible assumption again, only until it removes the candidates, to keep the trace short
+			removed := func(t *Game) bool {
+				return !slices.ContainsFunc(remove, func(c string) bool { return !(trial{game: t}).removes(l, c) })
+			}
+			branches := []ForcingBranch{}
+			for _, a := range assumptions {
+				t := trials[a]
+				if t.branch.Contradiction == "" {
+					t = g.assume(a, removed)
+				}
+				branches = append(branches, t.branch)
+			}
+			return true, Hint{
+				Loc:                l,
+				CandidatesToRemove: remove,
+				Eliminator:         name,
+				cell:               cell,
+				Forcing:            &ForcingHint{Name: name, Branches: branches},
+			}, nil
+		}
+	}
+	return false, Hint{}, nil
+}
@@ -0,0 +1,70 @@
+package sudoku
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEliminatorNishio(t *testing.T) {
+	g := emptyGame(t)
+	setCandidates(g, Loc{X: 0, Y: 0}, "1", "2")
+	setCandidates(g, Loc{X: 1, Y: 0}, "1", "3")
+	setCandidates(g, Loc{X: 2, Y: 0}, "1", "3")
+
+	ok, h, err := EliminatorNishio.GameHinter(g)
+	require.NoError(t, err)
+	require.True(t, ok)
+	t.Log(h.String())
+	assert.Equal(t, Loc{X: 0, Y: 0}, h.Loc)
+	assert.Equal(t, []string{"1"}, h.CandidatesToRemove)
+	require.Len(t, h.Forcing.Branches, 1)
+	assert.NotEmpty(t, h.Forcing.Branches[0].Trace)
+	assert.NotEmpty(t, h.Forcing.Branches[0].Contradiction)
+}
+
+func TestEliminatorCellForcing(t *testing.T) {
+	g := emptyGame(t)
+	setCandidates(g, Loc{X: 0, Y: 0}, "1", "2")
+	setCandidates(g, Loc{X: 0, Y: 4}, "1", "5")
+	setCandidates(g, Loc{X: 4, Y: 0}, "2", "5")
+
+	ok, h, err := EliminatorCellForcing.GameHinter(g)
+	require.NoError(t, err)
+	require.True(t, ok)
+	t.Log(h.String())
+	assert.Equal(t, Loc{X: 4, Y: 4}, h.Loc)
+	assert.Equal(t, []string{"5"}, h.CandidatesToRemove)
+	require.Len(t, h.Forcing.Branches, 2)
+	for _, b := range h.Forcing.Branches {
+		assert.Empty(t, b.Contradiction)
+		assert.Contains(t, b.Trace[len(b.Trace)-1], "(x:4,y:4) [5]")
+	}
+}
+
+func TestEliminatorUnitForcing(t *testing.T) {
+	g := emptyGame(t)
+	keepSymbol(g, "1", []string{"x...x....", "xxxxxxxxx", "xxxxxxxxx", "xxxxxxxxx", "xxxxxxxxx", "xxxxxxxxx", "xxxxxxxxx", "xxxxxxxxx", "xxxxxxxxx"})
+	setCandidates(g, Loc{X: 0, Y: 0}, "1", "2")
+	setCandidates(g, Loc{X: 4, Y: 0}, "1", "2")
+
+	ok, h, err := EliminatorUnitForcing.GameHinter(g)
+	require.NoError(t, err)
+	require.True(t, ok)
+	t.Log(h.String())
+	assert.Equal(t, Loc{X: 1, Y: 0}, h.Loc)
+	assert.Equal(t, []string{"2"}, h.CandidatesToRemove)
+	assert.Equal(t, "Unit Forcing Chain", h.Forcing.Name)
+	require.Len(t, h.Forcing.Branches, 2)
+}
+
+func TestForcingIsOptIn(t *testing.T) {
+	g := emptyGame(t)
+	assert.False(t, g.eliminatorEnabled(EliminatorNishio))
+
+	g.AllowForcing = true
+	assert.True(t, g.eliminatorEnabled(EliminatorNishio))
+	assert.True(t, g.eliminatorEnabled(EliminatorCellForcing))
+	assert.True(t, g.eliminatorEnabled(EliminatorUnitForcing))
+}
@@ -0,0 +1,158 @@
+// Package generate creates new puzzles that have a single solution.
+package generate
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+
+	"github.com/mvndaai/sudoku_hints/sudoku"
+)
+
+type Symmetry int
+
+const (
+	NoSymmetry Symmetry = iota
+	Rotational          // Clues are removed in pairs turned half way around the center
+	Diagonal            // Clues are removed in pairs mirrored across the diagonal from the top left corner
+)
+
+// partners returns the cell and the cells the symmetry removes with it.
+func (s Symmetry) partners(l sudoku.Loc, size int) []sudoku.Loc {
+	locs := []sudoku.Loc{l}
+	var p sudoku.Loc
+	switch s {
+	case Rotational:
+		p = sudoku.Loc{X: size - 1 - l.X, Y: size - 1 - l.Y}
+	case Diagonal:
+		p = sudoku.Loc{X: l.Y, Y: l.X}
+	default:
+		return locs
+	}
+	if p != l {
+		locs = append(locs, p)
+	}
+	return locs
+}
+
+type Options struct {
+	Groups   map[sudoku.Loc]int // Defaults to sudoku.DefaultGroup9x9
+	Symbols  []string           // Defaults to 1-9, the board has a row and column for each symbol
+	Symmetry Symmetry
+	Target   string     // Name of the hardest eliminator the puzzle should need, or sudoku.NakedSingle, see sudoku.Game.HardestEliminator. Empty accepts any puzzle the eliminators solve
+	Attempts int        // Puzzles to try before giving up on the target, defaults to 50
+	Rand     *rand.Rand // Defaults to a randomly seeded source
+}
+
+type Puzzle struct {
+	Cells    [][]string `json:"cells"` // Empty strings are cells left to solve
+	Solution [][]string `json:"solution"`
+	Hardest  string     `json:"hardest"` // The hardest eliminator needed to solve the puzzle
+}
+
+// Generate removes clues from random full grids until it finds a puzzle that needs the target eliminator.
+func Generate(opts Options) (Puzzle, error) {
+	if opts.Groups == nil {
+		opts.Groups = sudoku.DefaultGroup9x9
+	}
+	if opts.Symbols == nil {
+		opts.Symbols = []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}
+	}
+	if opts.Attempts <= 0 {
+		opts.Attempts = 50
+	}
+	if opts.Rand == nil {
+		opts.Rand = rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))
+	}
+	if err := checkTarget(opts.Target); err != nil {
+		return Puzzle{}, err
+	}
+
+	for range opts.Attempts {
+		p, err := generate(opts)
+		if err != nil {
+			return Puzzle{}, err
+		}
+
+		// Generated puzzles have a single solution, so the uniqueness and forcing eliminators can rate them
+		g := &sudoku.Game{AssumeUnique: true, AllowForcing: true}
+		if err := g.Fill(p.Cells, opts.Groups, slices.Clone(opts.Symbols)); err != nil {
+			return Puzzle{}, err
+		}
+		hardest, solved := g.HardestEliminator()
+		if !solved {
+			continue
+		}
+		if opts.Target == "" || hardest == opts.Target {
+			p.Hardest = hardest
+			return p, nil
+		}
+	}
+	return Puzzle{}, fmt.Errorf("no puzzle needing %q found in %d attempts", opts.Target, opts.Attempts)
+}
+
+// checkTarget makes sure the target is something HardestEliminator can return for a generated puzzle, which has no
+// variants.
+func checkTarget(target string) error {
+	if target == "" || target == sudoku.NakedSingle {
+		return nil
+	}
+	i := slices.IndexFunc(sudoku.Eliminators, func(e sudoku.CandidateEliminator) bool { return e.Name == target })
+	if i < 0 {
+		return fmt.Errorf("unknown eliminator %q", target)
+	}
+	if v := sudoku.Eliminators[i].Variant; v != "" {
+		return fmt.Errorf("eliminator %q only runs on %q puzzles, generated puzzles have no variants", target, v)
+	}
+	return nil
+}
+
+// generate fills a random grid, then removes clues in a random order as long as the puzzle keeps a single solution.
+func generate(opts Options) (Puzzle, error) {
+	size := len(opts.Symbols)
+	empty := make([][]string, size)
+	for y := range empty {
+		empty[y] = make([]string, size)
+	}
+	g := &sudoku.Game{}
+	if err := g.Fill(empty, opts.Groups, slices.Clone(opts.Symbols)); err != nil {
+		return Puzzle{}, err
+	}
+	solution, err := g.RandomSolution(opts.Rand)
+	if err != nil {
+		return Puzzle{}, err
+	}
+
+	locs := []sudoku.Loc{}
+	for y := range g.Board {
+		for x := range g.Board[y] {
+			g.Board[y][x].Cell.Value = solution[y][x]
+			locs = append(locs, sudoku.Loc{X: x, Y: y})
+		}
+	}
+	opts.Rand.Shuffle(len(locs), func(i, j int) { locs[i], locs[j] = locs[j], locs[i] })
+
+	for _, l := range locs {
+		if g.Board[l.Y][l.X].Cell.Value == "" {
+			continue
+		}
+		partners := opts.Symmetry.partners(l, size)
+		for _, p := range partners {
+			g.Board[p.Y][p.X].Cell.Value = ""
+		}
+		if !g.HasUniqueSolution() {
+			for _, p := range partners {
+				g.Board[p.Y][p.X].Cell.Value = solution[p.Y][p.X]
+			}
+		}
+	}
+
+	cells := make([][]string, size)
+	for y := range g.Board {
+		cells[y] = make([]string, size)
+		for x := range g.Board[y] {
+			cells[y][x] = g.Board[y][x].Cell.Value
+		}
+	}
+	return Puzzle{Cells: cells, Solution: solution}, nil
+}
@@ -0,0 +1,96 @@
+package generate
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/mvndaai/sudoku_hints/sudoku"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+	tests := []struct {
+		name     string
+		symmetry Symmetry
+		mirror   func(l sudoku.Loc) sudoku.Loc
+	}{
+		{name: "none", symmetry: NoSymmetry},
+		{name: "rotational", symmetry: Rotational, mirror: func(l sudoku.Loc) sudoku.Loc { return sudoku.Loc{X: 8 - l.X, Y: 8 - l.Y} }},
+		{name: "diagonal", symmetry: Diagonal, mirror: func(l sudoku.Loc) sudoku.Loc { return sudoku.Loc{X: l.Y, Y: l.X} }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := Generate(Options{Symmetry: tt.symmetry, Rand: rand.New(rand.NewPCG(1, 2))})
+			require.NoError(t, err)
+			assert.NotEmpty(t, p.Hardest)
+
+			g := &sudoku.Game{}
+			require.NoError(t, g.Fill(p.Cells, sudoku.DefaultGroup9x9, []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}))
+			assert.True(t, g.HasUniqueSolution())
+			solution, err := g.Solve()
+			require.NoError(t, err)
+			assert.Equal(t, p.Solution, solution)
+
+			for y, row := range p.Cells {
+				for x, v := range row {
+					if v != "" {
+						assert.Equal(t, p.Solution[y][x], v)
+					}
+					if tt.mirror != nil {
+						m := tt.mirror(sudoku.Loc{X: x, Y: y})
+						assert.Equal(t, v == "", p.Cells[m.Y][m.X] == "", "clue at (x:%d,y:%d) is not mirrored", x, y)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestGenerateTarget(t *testing.T) {
+	p, err := Generate(Options{Target: "Unique Candidate", Rand: rand.New(rand.NewPCG(3, 4))})
+	require.NoError(t, err)
+	assert.Equal(t, "Unique Candidate", p.Hardest)
+
+	g := &sudoku.Game{}
+	require.NoError(t, g.Fill(p.Cells, sudoku.DefaultGroup9x9, []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}))
+	hardest, solved := g.HardestEliminator()
+	assert.True(t, solved)
+	assert.Equal(t, "Unique Candidate", hardest)
+
+	_, err = Generate(Options{Target: "Not An Eliminator"})
+	assert.Error(t, err)
+}
+
+func TestGenerateTargetNakedSingle(t *testing.T) {
+	p, err := Generate(Options{Target: sudoku.NakedSingle, Rand: rand.New(rand.NewPCG(2, 7))})
+	require.NoError(t, err)
+	assert.Equal(t, sudoku.NakedSingle, p.Hardest)
+}
+
+func TestGenerateTargetOptIn(t *testing.T) {
+	// Generated puzzles have one solution, so they are rated with the uniqueness eliminators
+	p, err := Generate(Options{Target: "Unique Rectangle", Rand: rand.New(rand.NewPCG(16, 7))})
+	require.NoError(t, err)
+	assert.Equal(t, "Unique Rectangle", p.Hardest)
+
+	_, err = Generate(Options{Target: sudoku.EliminatorFistemafelRing.Name})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "generated puzzles have no variants")
+}
+
+func TestGenerate4x4(t *testing.T) {
+	p, err := Generate(Options{
+		Groups:   sudoku.DefaultGroup4x4,
+		Symbols:  []string{"1", "2", "3", "4"},
+		Symmetry: Rotational,
+		Rand:     rand.New(rand.NewPCG(5, 6)),
+	})
+	require.NoError(t, err)
+	require.Len(t, p.Cells, 4)
+
+	g := &sudoku.Game{}
+	require.NoError(t, g.Fill(p.Cells, sudoku.DefaultGroup4x4, []string{"1", "2", "3", "4"}))
+	assert.True(t, g.HasUniqueSolution())
+}
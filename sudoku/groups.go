@@ -1,10 +1,220 @@
 package sudoku
 
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+)
+
 type Loc struct {
 	X int
 	Y int
 }
 
+// formatLocs prints locations the same way eliminator changes do.
+func formatLocs(locs []Loc) string {
+	s := ""
+	for i, l := range locs {
+		if i != 0 {
+			s += " "
+		}
+		s += fmt.Sprintf("(x:%d,y:%d)", l.X, l.Y)
+	}
+	return s
+}
+
+func (g *Game) groupOf(l Loc) int {
+	return g.Board[l.Y][l.X].group
+}
+
+// Sees reports whether two different cells share a row, column, group, extra house, cage, thermometer or renban line,
+// or are a knight's or king's move apart in games with those rules, so they cannot hold the same value.
+func (g *Game) Sees(a, b Loc) bool {
+	if a == b {
+		return false
+	}
+	if a.X == b.X || a.Y == b.Y || g.groupOf(a) == g.groupOf(b) || g.chessSees(a, b) {
+		return true
+	}
+	if i := g.cageOf(a); i >= 0 && slices.Contains(g.Cages[i].Cells, b) {
+		return true
+	}
+	if slices.ContainsFunc(g.Lines, func(l Line) bool {
+		return l.distinct() && slices.Contains(l.Cells, a) && slices.Contains(l.Cells, b)
+	}) {
+		return true
+	}
+	return slices.ContainsFunc(g.Houses, func(h ExtraHouse) bool {
+		return slices.Contains(h.Cells, a) && slices.Contains(h.Cells, b)
+	})
+}
+
+// Peers returns every cell that sees the location.
+func (g *Game) Peers(l Loc) []LocCell {
+	return g.CommonPeers([]Loc{l})
+}
+
+// CommonPeers returns the cells that see every one of the locations.
+func (g *Game) CommonPeers(locs []Loc) []LocCell {
+	peers := []LocCell{}
+	for y := range g.Board {
+		for x := range g.Board[y] {
+			loc := Loc{X: x, Y: y}
+			if slices.ContainsFunc(locs, func(l Loc) bool { return !g.Sees(l, loc) }) {
+				continue
+			}
+			peers = append(peers, LocCell{Loc: loc, Cell: g.Board[y][x].Cell})
+		}
+	}
+	return peers
+}
+
+// Intersection is where a group crosses a row or column.
+type Intersection struct {
+	House     string    // "row" or "column"
+	Index     int       // Which row or column
+	Group     int       // Which group
+	Cells     []LocCell // Cells in both the line and the group
+	LineRest  []LocCell // Cells in the line outside the group
+	GroupRest []LocCell // Cells in the group outside the line
+}
+
+// Intersections returns every place a group crosses a row or column, rows first.
+func (g *Game) Intersections() []Intersection {
+	rows, cols, groups := g.GetSectionedCells()
+	lines := []struct {
+		name  string
+		cells [][]LocCell
+		index func(Loc) int
+	}{
+		{"row", rows, func(l Loc) int { return l.Y }},
+		{"column", cols, func(l Loc) int { return l.X }},
+	}
+
+	intersections := []Intersection{}
+	for _, line := range lines {
+		for i, lineCells := range line.cells {
+			for group, groupCells := range groups {
+				in := Intersection{House: line.name, Index: i, Group: group}
+				for _, lc := range lineCells {
+					if g.groupOf(lc.Loc) == group {
+						in.Cells = append(in.Cells, lc)
+						continue
+					}
+					in.LineRest = append(in.LineRest, lc)
+				}
+				if len(in.Cells) == 0 {
+					continue
+				}
+				for _, lc := range groupCells {
+					if line.index(lc.Loc) != i {
+						in.GroupRest = append(in.GroupRest, lc)
+					}
+				}
+				intersections = append(intersections, in)
+			}
+		}
+	}
+	return intersections
+}
+
+// ExtraHouse is a set of cells that holds every symbol once on top of the rows, columns and groups, like the
+// diagonals of X-Sudoku or the windows of Windoku.
+type ExtraHouse struct {
+	Name  string `json:"name"`
+	Cells []Loc  `json:"cells"`
+}
+
+// OnBoard reports whether the location is a cell of the board.
+func (g *Game) OnBoard(l Loc) bool {
+	return l.Y >= 0 && l.Y < len(g.Board) && l.X >= 0 && l.X < len(g.Board[l.Y])
+}
+
+// locCells returns the cells at the locations, in the same order.
+func (g *Game) locCells(locs []Loc) []LocCell {
+	cells := make([]LocCell, 0, len(locs))
+	for _, l := range locs {
+		cells = append(cells, LocCell{Loc: l, Cell: g.Board[l.Y][l.X].Cell})
+	}
+	return cells
+}
+
+// ExtraHouseCells returns the cells of each extra house, in the order of Game.Houses.
+func (g *Game) ExtraHouseCells() [][]LocCell {
+	houses := make([][]LocCell, len(g.Houses))
+	for i, h := range g.Houses {
+		houses[i] = g.locCells(h.Cells)
+	}
+	return houses
+}
+
+// checkHouses makes sure every extra house has one cell on the board for each symbol.
+func (g *Game) checkHouses() error {
+	for _, h := range g.Houses {
+		if len(h.Cells) != len(g.Symbols) {
+			return fmt.Errorf("house '%s' has %d cells, it needs one for each of the %d symbols", h.Name, len(h.Cells), len(g.Symbols))
+		}
+		for i, l := range h.Cells {
+			if !g.OnBoard(l) {
+				return fmt.Errorf("house '%s' has %v outside of the board", h.Name, l)
+			}
+			if slices.Contains(h.Cells[:i], l) {
+				return fmt.Errorf("house '%s' has %v more than once", h.Name, l)
+			}
+		}
+	}
+	return nil
+}
+
+// DiagonalHouses returns the two main diagonals of a square board, which makes an X-Sudoku.
+func DiagonalHouses(size int) []ExtraHouse {
+	main := ExtraHouse{Name: "main diagonal"}
+	anti := ExtraHouse{Name: "anti diagonal"}
+	for i := range size {
+		main.Cells = append(main.Cells, Loc{X: i, Y: i})
+		anti.Cells = append(anti.Cells, Loc{X: size - 1 - i, Y: i})
+	}
+	return []ExtraHouse{main, anti}
+}
+
+// WindokuHouses9x9 are the four shaded windows of a 9x9 Windoku.
+var WindokuHouses9x9 = func() []ExtraHouse {
+	houses := []ExtraHouse{}
+	for _, corner := range []Loc{{X: 1, Y: 1}, {X: 5, Y: 1}, {X: 1, Y: 5}, {X: 5, Y: 5}} {
+		h := ExtraHouse{Name: fmt.Sprintf("window %d", len(houses))}
+		for y := corner.Y; y < corner.Y+3; y++ {
+			for x := corner.X; x < corner.X+3; x++ {
+				h.Cells = append(h.Cells, Loc{X: x, Y: y})
+			}
+		}
+		houses = append(houses, h)
+	}
+	return houses
+}()
+
+// DisjointGroupHouses returns a house for each position inside a group, so the cells in the same spot of every group
+// hold different values. Cells are numbered by row then column within their group.
+func DisjointGroupHouses(group map[Loc]int) []ExtraHouse {
+	locs := slices.SortedFunc(maps.Keys(group), func(a, b Loc) int {
+		if a.Y != b.Y {
+			return cmp.Compare(a.Y, b.Y)
+		}
+		return cmp.Compare(a.X, b.X)
+	})
+	positions := map[int]int{} // Cells seen so far in each group
+	houses := []ExtraHouse{}
+	for _, l := range locs {
+		p := positions[group[l]]
+		positions[group[l]]++
+		if p == len(houses) {
+			houses = append(houses, ExtraHouse{Name: fmt.Sprintf("disjoint set %d", p)})
+		}
+		houses[p].Cells = append(houses[p].Cells, l)
+	}
+	return houses
+}
+
 // These are default groups for a standard Sudoku game.
 // There are always the same number of groups as there are symbols.
 
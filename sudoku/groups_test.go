@@ -0,0 +1,85 @@
+package sudoku
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtraHouses(t *testing.T) {
+	g := &Game{Houses: DiagonalHouses(9)}
+	cells := emptyCells()
+	cells[0][0] = 1
+	cells[8][0] = 2
+	require.NoError(t, g.FillBasic(cells))
+
+	assert.True(t, g.Sees(Loc{X: 0, Y: 0}, Loc{X: 8, Y: 8}))
+	assert.False(t, g.Sees(Loc{X: 0, Y: 0}, Loc{X: 7, Y: 8}))
+	assert.False(t, g.Board[4][4].Cell.HasCandidate("1"), "the main diagonal already has 1")
+	assert.False(t, g.Board[4][4].Cell.HasCandidate("2"), "the anti diagonal already has 2")
+	assert.True(t, g.Board[4][3].Cell.HasCandidate("1"))
+
+	require.NoError(t, g.SetValue(8, 8, "3"))
+	err := g.SetValue(4, 4, "3")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "main diagonal")
+}
+
+func TestExtraHouseHint(t *testing.T) {
+	g := &Game{Houses: DiagonalHouses(9)}
+	require.NoError(t, g.FillBasic(emptyCells()))
+	setCandidates(g, Loc{X: 0, Y: 0}, "1", "2")
+	setCandidates(g, Loc{X: 4, Y: 4}, "1", "2")
+
+	ok, h, err := g.findHint([]CandidateEliminator{EliminatorCandidateChains})
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, Loc{X: 1, Y: 1}, h.Loc)
+	require.Len(t, h.Houses, 1)
+	assert.Equal(t, "extra", h.Houses[0].Type)
+	assert.Equal(t, "main diagonal", h.Houses[0].Name)
+	assert.Len(t, h.Houses[0].Cells, 9)
+	assert.Equal(t, "Look for Candidate Chains in main diagonal", h.AtLevel(HintHouse).Message)
+}
+
+func TestExtraHouseSolve(t *testing.T) {
+	g := &Game{Houses: WindokuHouses9x9}
+	require.NoError(t, g.FillBasic(emptyCells()))
+	solution, err := g.Solve()
+	require.NoError(t, err)
+	for _, h := range g.Houses {
+		seen := map[string]bool{}
+		for _, l := range h.Cells {
+			assert.False(t, seen[solution[l.Y][l.X]], "%s repeats %s", h.Name, solution[l.Y][l.X])
+			seen[solution[l.Y][l.X]] = true
+		}
+	}
+}
+
+func TestDisjointGroupHouses(t *testing.T) {
+	houses := DisjointGroupHouses(DefaultGroup9x9)
+	require.Len(t, houses, 9)
+	assert.Equal(t, "disjoint set 0", houses[0].Name)
+	assert.Equal(t, []Loc{{X: 0, Y: 0}, {X: 3, Y: 0}, {X: 6, Y: 0}, {X: 0, Y: 3}, {X: 3, Y: 3}, {X: 6, Y: 3}, {X: 0, Y: 6}, {X: 3, Y: 6}, {X: 6, Y: 6}}, houses[0].Cells)
+	for _, h := range houses {
+		assert.Len(t, h.Cells, 9)
+	}
+}
+
+func TestCheckHouses(t *testing.T) {
+	tests := []struct {
+		name  string
+		house ExtraHouse
+	}{
+		{name: "too few cells", house: ExtraHouse{Name: "short", Cells: []Loc{{X: 0, Y: 0}}}},
+		{name: "outside of the board", house: ExtraHouse{Name: "outside", Cells: append(DiagonalHouses(8)[0].Cells, Loc{X: 9, Y: 9})}},
+		{name: "repeated cell", house: ExtraHouse{Name: "repeat", Cells: append(DiagonalHouses(8)[0].Cells, Loc{X: 0, Y: 0})}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &Game{Houses: []ExtraHouse{tt.house}}
+			assert.Error(t, g.FillBasic(emptyCells()))
+		})
+	}
+}
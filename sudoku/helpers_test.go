@@ -0,0 +1,63 @@
+package sudoku
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// emptyGame returns a 9x9 game where every cell still has every candidate.
+func emptyGame(t *testing.T) *Game {
+	t.Helper()
+	g := &Game{}
+	require.NoError(t, g.FillBasic(emptyCells()))
+	return g
+}
+
+// emptyCells returns a 9x9 puzzle without any values.
+func emptyCells() [][]int {
+	cells := make([][]int, 9)
+	for y := range cells {
+		cells[y] = make([]int, 9)
+	}
+	return cells
+}
+
+// keepSymbol removes the symbol from every cell marked with '.' in the pattern.
+func keepSymbol(g *Game, symbol string, pattern []string) {
+	for y, row := range pattern {
+		for x, c := range row {
+			if c == '.' {
+				g.Board[y][x].Cell.RemoveCandiates([]string{symbol})
+			}
+		}
+	}
+	g.RemoveAllRecentCandidates()
+}
+
+// setCandidates replaces the candidates of a cell.
+func setCandidates(g *Game, loc Loc, candidates ...string) {
+	g.Board[loc.Y][loc.X].Cell.SetCandidates(candidates...)
+}
+
+// newCell returns a cell that is not part of a game.
+func newCell(value string, candidates ...string) *Cell {
+	c := &Cell{Value: value}
+	c.SetCandidates(candidates...)
+	return c
+}
+
+// collectChanges applies a game eliminator until it stops and returns the changes it made.
+func collectChanges(t *testing.T, g *Game, e CandidateEliminator) []string {
+	t.Helper()
+	changes := []string{}
+	for {
+		change, err := e.GameEliminator(g)
+		require.NoError(t, err)
+		if change == "" {
+			return changes
+		}
+		changes = append(changes, change)
+		t.Log(change)
+	}
+}
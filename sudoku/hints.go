@@ -0,0 +1,256 @@
+package sudoku
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Target is a cell a hint removes candidates from.
+type Target struct {
+	Loc        Loc      `json:"loc"`
+	Candidates []string `json:"candidates"`
+
+	cell *Cell
+}
+
+// Cause is a cell whose value or candidates justify a hint.
+type Cause struct {
+	Loc        Loc      `json:"loc"`
+	Candidates []string `json:"candidates,omitempty"` // The candidates the pattern uses, or the value of a filled cell
+}
+
+// House is a set of cells a hint looks at: a row, column, group, extra house, cage, line or the cells a rule reaches.
+type House struct {
+	Type  string `json:"type"`  // "row", "column", "group", "extra", "cage", "line" or "rule"
+	Index int    `json:"index"` // Index of the row, column or group, or in Game.Houses, Game.Cages or Game.Lines
+	Name  string `json:"name,omitempty"`
+	Cells []Loc  `json:"cells,omitempty"`
+}
+
+// targets returns every cell the hint removes candidates from. Hints that only set Loc have a single target.
+func (h Hint) targets() []Target {
+	if len(h.Targets) > 0 {
+		return h.Targets
+	}
+	return []Target{{Loc: h.Loc, Candidates: h.CandidatesToRemove, cell: h.cell}}
+}
+
+// apply removes the candidates from every target and returns how many were removed.
+func (h Hint) apply() int {
+	removed := 0
+	for _, t := range h.targets() {
+		if t.cell != nil {
+			removed += len(t.cell.RemoveCandiates(t.Candidates))
+		}
+	}
+	return removed
+}
+
+// describeHint fills in the targets of a hint and adds the causes and houses of its pattern so the UI can highlight them.
+func (g *Game) describeHint(h Hint) Hint {
+	h.Targets = h.targets()
+
+	type explainer interface {
+		explain(g *Game) ([]Cause, []House)
+	}
+	details := []explainer{}
+	if h.Fish != nil {
+		details = append(details, h.Fish)
+	}
+	if h.Wing != nil {
+		details = append(details, h.Wing)
+	}
+	if h.SingleDigit != nil {
+		details = append(details, h.SingleDigit)
+	}
+	if h.Coloring != nil {
+		details = append(details, h.Coloring)
+	}
+	if h.Chain != nil {
+		details = append(details, h.Chain)
+	}
+	if h.Uniqueness != nil {
+		details = append(details, h.Uniqueness)
+	}
+	if h.ALS != nil {
+		details = append(details, h.ALS)
+	}
+	if h.SueDeCoq != nil {
+		details = append(details, h.SueDeCoq)
+	}
+	if h.Forcing != nil {
+		details = append(details, h.Forcing)
+	}
+	if h.CandidateChain != nil {
+		details = append(details, h.CandidateChain)
+	}
+	if h.Ring != nil {
+		details = append(details, h.Ring)
+	}
+	if h.Cage != nil {
+		details = append(details, h.Cage)
+	}
+	if h.Line != nil {
+		details = append(details, h.Line)
+	}
+
+	for _, d := range details {
+		causes, houses := d.explain(g)
+		h.Causes = mergeCauses(h.Causes, causes...)
+		h.Houses = addHouses(h.Houses, houses...)
+	}
+
+	sections := map[string][][]LocCell{}
+	for _, p := range g.partitions() {
+		sections[p.house] = p.cells
+	}
+	sections["cage"] = g.cageCells()
+	sections["line"] = g.lineCells()
+	for i, house := range h.Houses {
+		if house.Type == "rule" {
+			continue // Rules name their own cells
+		}
+		h.Houses[i].Name = g.houseName(house.Type, house.Index)
+		h.Houses[i].Cells = []Loc{}
+		for _, lc := range sections[house.Type][house.Index] {
+			h.Houses[i].Cells = append(h.Houses[i].Cells, lc.Loc)
+		}
+	}
+	return h
+}
+
+// addHouses adds the houses that are not in the list yet.
+func addHouses(houses []House, add ...House) []House {
+	for _, h := range add {
+		if !slices.ContainsFunc(houses, func(e House) bool { return e.Type == h.Type && e.Index == h.Index }) {
+			houses = append(houses, h)
+		}
+	}
+	return houses
+}
+
+// causes returns the cells as causes of a hint. Filled cells give their value, the others the candidates they have
+// out of symbols, or all of them when no symbols are given.
+func (g *Game) causes(locs []Loc, symbols ...string) []Cause {
+	causes := []Cause{}
+	for _, l := range locs {
+		cell := g.Board[l.Y][l.X].Cell
+		c := Cause{Loc: l}
+		switch {
+		case cell.Value != "":
+			c.Candidates = []string{cell.Value}
+		case len(symbols) == 0:
+			c.Candidates = cell.Candidates()
+		default:
+			c.Candidates = slices.DeleteFunc(cell.Candidates(), func(s string) bool { return !slices.Contains(symbols, s) })
+		}
+		causes = mergeCauses(causes, c)
+	}
+	return causes
+}
+
+// mergeCauses adds causes to a list, joining the candidates of causes in the same cell.
+func mergeCauses(causes []Cause, add ...Cause) []Cause {
+	for _, c := range add {
+		i := slices.IndexFunc(causes, func(e Cause) bool { return e.Loc == c.Loc })
+		if i < 0 {
+			causes = append(causes, Cause{Loc: c.Loc, Candidates: slices.Clone(c.Candidates)})
+			continue
+		}
+		for _, s := range c.Candidates {
+			if !slices.Contains(causes[i].Candidates, s) {
+				causes[i].Candidates = append(causes[i].Candidates, s)
+			}
+		}
+	}
+	return causes
+}
+
+// HintLevel is how much of the next hint to give away, so players can learn the technique before being told.
+type HintLevel int
+
+const (
+	HintTechnique HintLevel = iota + 1 // Names the eliminator
+	HintHouse                          // Names the houses to look at
+	HintCells                          // Shows the cells that make up the pattern and the cells it removes from
+	HintApply                          // Applies the elimination
+)
+
+// LeveledHint is the part of a hint given at a level.
+type LeveledHint struct {
+	Level   HintLevel `json:"level"`
+	Message string    `json:"message"`
+	Hint    Hint      `json:"hint"` // Only holds the fields the level gives away
+}
+
+// AtLevel returns the part of the hint given at the level. Hints without houses point at the row of their first target.
+func (h Hint) AtLevel(level HintLevel) LeveledHint {
+	switch {
+	case level < HintTechnique:
+		level = HintTechnique
+	case level > HintApply:
+		level = HintApply
+	}
+	lh := LeveledHint{Level: level, Hint: Hint{Eliminator: h.Eliminator}}
+
+	houses := h.Houses
+	if len(houses) == 0 {
+		houses = []House{{Type: "row", Index: h.Loc.Y}}
+	}
+	names := ""
+	for i, house := range houses {
+		switch {
+		case i == 0:
+		case i == len(houses)-1:
+			names += " and "
+		default:
+			names += ", "
+		}
+		if house.Name != "" {
+			names += house.Name
+			continue
+		}
+		names += fmt.Sprintf("%s %d", house.Type, house.Index)
+	}
+
+	switch level {
+	case HintTechnique:
+		lh.Message = fmt.Sprintf("Look for %s", h.Eliminator)
+	case HintHouse:
+		lh.Hint.Houses = houses
+		lh.Message = fmt.Sprintf("Look for %s in %s", h.Eliminator, names)
+	case HintCells:
+		lh.Hint.Houses = houses
+		lh.Hint.Causes = h.Causes
+		causes := []Loc{}
+		for _, c := range h.Causes {
+			causes = append(causes, c.Loc)
+		}
+		targets := []Loc{}
+		for _, t := range h.targets() {
+			lh.Hint.Targets = append(lh.Hint.Targets, Target{Loc: t.Loc})
+			targets = append(targets, t.Loc)
+		}
+		lh.Message = fmt.Sprintf("Look for %s using %s, it removes candidates from %s", h.Eliminator, formatLocs(causes), formatLocs(targets))
+	case HintApply:
+		lh.Hint = h
+		lh.Message = fmt.Sprintf("[%s] %s", h.Eliminator, h)
+	}
+	return lh
+}
+
+// HintAt returns the next hint at the level. Only HintApply changes the board. It is false when none of the
+// eliminators can remove a candidate.
+func (g *Game) HintAt(level HintLevel) (bool, LeveledHint, error) {
+	ok, h, err := g.NextHint()
+	if err != nil || !ok {
+		return false, LeveledHint{}, err
+	}
+	lh := h.AtLevel(level)
+	if lh.Level == HintApply {
+		if err := g.ApplyHint(h); err != nil {
+			return false, LeveledHint{}, err
+		}
+	}
+	return true, lh, nil
+}
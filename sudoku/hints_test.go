@@ -0,0 +1,207 @@
+package sudoku
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mvndaai/sudoku_hints/sudoku/boards"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEveryEliminatorHasHinter(t *testing.T) {
+	for _, e := range Eliminators {
+		assert.True(t, e.PartitionHinter != nil || e.GameHinter != nil, e.Name)
+	}
+}
+
+func TestHintPartition(t *testing.T) {
+	g := emptyGame(t)
+	setCandidates(g, Loc{X: 0, Y: 0}, "1", "2")
+	setCandidates(g, Loc{X: 1, Y: 0}, "1", "2")
+
+	ok, h, err := g.findHint([]CandidateEliminator{EliminatorCandidateChains})
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, "removed candidates (x:2,y:0) [1 2] from chain of size 2", h.String())
+	assert.Equal(t, "Candidate Chains", h.Eliminator)
+	require.Len(t, h.Targets, 1)
+	assert.Equal(t, Target{Loc: Loc{X: 2, Y: 0}, Candidates: []string{"1", "2"}, cell: h.cell}, h.Targets[0])
+	assert.Equal(t, []Cause{
+		{Loc: Loc{X: 0, Y: 0}, Candidates: []string{"1", "2"}},
+		{Loc: Loc{X: 1, Y: 0}, Candidates: []string{"1", "2"}},
+	}, h.Causes)
+	require.Len(t, h.Houses, 1)
+	assert.Equal(t, "row", h.Houses[0].Type)
+	assert.Equal(t, 0, h.Houses[0].Index)
+	assert.Len(t, h.Houses[0].Cells, 9)
+}
+
+func TestHintGroupAndRowColumn(t *testing.T) {
+	g := &Game{}
+	require.NoError(t, g.FillBasic([][]int{
+		{9, 8, 7, 1, 2, 3, 0, 0, 0},
+		{6, 5, 4, 0, 0, 0, 0, 0, 0},
+		{0, 0, 0, 0, 0, 0, 0, 0, 0},
+
+		{0, 0, 0, 0, 0, 0, 0, 0, 0},
+		{0, 0, 0, 0, 0, 0, 0, 0, 0},
+		{0, 0, 0, 0, 0, 0, 0, 0, 0},
+
+		{0, 0, 0, 0, 0, 0, 0, 0, 0},
+		{0, 0, 0, 0, 0, 0, 0, 0, 0},
+		{0, 0, 0, 0, 0, 0, 0, 0, 0},
+	}))
+
+	ok, h, err := g.findHint([]CandidateEliminator{EliminatorGroupAndRowColumn})
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, "removed candidates (x:6,y:2) [1 2 3]", h.String())
+	assert.Equal(t, []Cause{
+		{Loc: Loc{X: 0, Y: 2}, Candidates: []string{"1", "2", "3"}},
+		{Loc: Loc{X: 1, Y: 2}, Candidates: []string{"1", "2", "3"}},
+		{Loc: Loc{X: 2, Y: 2}, Candidates: []string{"1", "2", "3"}},
+	}, h.Causes)
+	require.Len(t, h.Houses, 2)
+	assert.Equal(t, House{Type: "group", Index: 0, Name: "group 0", Cells: h.Houses[0].Cells}, h.Houses[0])
+	assert.Equal(t, House{Type: "row", Index: 2, Name: "row 2", Cells: h.Houses[1].Cells}, h.Houses[1])
+	assert.Contains(t, h.Houses[1].Cells, Loc{X: 6, Y: 2})
+}
+
+func TestHintDetailCauses(t *testing.T) {
+	g := emptyGame(t)
+	setCandidates(g, Loc{X: 4, Y: 4}, "1", "2")
+	setCandidates(g, Loc{X: 4, Y: 0}, "1", "3")
+	setCandidates(g, Loc{X: 0, Y: 4}, "2", "3")
+
+	ok, h, err := g.findHint([]CandidateEliminator{EliminatorXYWing})
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, []string{"3"}, h.CandidatesToRemove)
+	assert.ElementsMatch(t, []Cause{
+		{Loc: Loc{X: 4, Y: 4}, Candidates: []string{"1", "2"}},
+		{Loc: Loc{X: 4, Y: 0}, Candidates: []string{"1", "3"}},
+		{Loc: Loc{X: 0, Y: 4}, Candidates: []string{"2", "3"}},
+	}, h.Causes)
+	assert.Empty(t, h.Houses)
+}
+
+func TestRemoveOneCandidate(t *testing.T) {
+	g := emptyGame(t)
+	setCandidates(g, Loc{X: 0, Y: 0}, "1", "2")
+	setCandidates(g, Loc{X: 1, Y: 0}, "1", "2")
+
+	ok, h, err := g.RemoveOneCandidate(true)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.NotEmpty(t, h.Targets)
+	for _, target := range h.Targets {
+		cell := g.Board[target.Loc.Y][target.Loc.X].Cell
+		for _, c := range target.Candidates {
+			assert.False(t, cell.HasCandidate(c), target)
+		}
+		assert.Subset(t, cell.RecentCandidates, target.Candidates)
+	}
+}
+
+func TestNextHint(t *testing.T) {
+	g := &Game{}
+	require.NoError(t, g.FillBasic(boards.NYTHard7July2025))
+	before, err := json.Marshal(g)
+	require.NoError(t, err)
+
+	ok, h, err := g.NextHint()
+	require.NoError(t, err)
+	require.True(t, ok)
+	t.Log(h.String())
+	after, err := json.Marshal(g)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(before), string(after), "NextHint changed the board")
+
+	// The same hint is found again until it is applied
+	_, again, err := g.NextHint()
+	require.NoError(t, err)
+	assert.Equal(t, h.String(), again.String())
+
+	// Apply the hint as the UI would, after a round trip through JSON
+	b, err := json.Marshal(h)
+	require.NoError(t, err)
+	var decoded Hint
+	require.NoError(t, json.Unmarshal(b, &decoded))
+	require.NoError(t, g.ApplyHint(decoded))
+	for _, target := range h.Targets {
+		cell := g.Board[target.Loc.Y][target.Loc.X].Cell
+		for _, c := range target.Candidates {
+			assert.False(t, cell.HasCandidate(c), target)
+		}
+	}
+	assert.Error(t, g.ApplyHint(decoded), "a hint that was applied does not remove anything")
+	assert.Error(t, g.ApplyHint(Hint{Loc: Loc{X: 9, Y: 0}, CandidatesToRemove: []string{"1"}}))
+}
+
+func TestCandidateDiffs(t *testing.T) {
+	c := newCell("", "1", "2", "3")
+	assert.Equal(t, []string{"1", "3"}, c.CandidateDiffs([]string{"", "3", "1", "5"}))
+	assert.Equal(t, []string{"1", "2", "3"}, c.Candidates())
+	assert.Nil(t, (&Cell{Value: "1"}).CandidateDiffs([]string{"1"}))
+}
+
+func TestHintLevels(t *testing.T) {
+	g := emptyGame(t)
+	setCandidates(g, Loc{X: 0, Y: 0}, "1", "2")
+	setCandidates(g, Loc{X: 1, Y: 0}, "1", "2")
+	ok, h, err := g.findHint([]CandidateEliminator{EliminatorCandidateChains})
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	tests := []struct {
+		level   HintLevel
+		message string
+		houses  int
+		causes  int
+	}{
+		{level: HintTechnique, message: "Look for Candidate Chains"},
+		{level: HintHouse, message: "Look for Candidate Chains in row 0", houses: 1},
+		{level: HintCells, message: "Look for Candidate Chains using (x:0,y:0) (x:1,y:0), it removes candidates from (x:2,y:0)", houses: 1, causes: 2},
+		{level: HintApply, message: "[Candidate Chains] removed candidates (x:2,y:0) [1 2] from chain of size 2", houses: 1, causes: 2},
+	}
+	for _, tt := range tests {
+		lh := h.AtLevel(tt.level)
+		assert.Equal(t, tt.level, lh.Level)
+		assert.Equal(t, tt.message, lh.Message)
+		assert.Len(t, lh.Hint.Houses, tt.houses)
+		assert.Len(t, lh.Hint.Causes, tt.causes)
+		if tt.level < HintApply {
+			assert.Empty(t, lh.Hint.CandidatesToRemove, "only the last level gives the answer")
+		}
+	}
+
+	// Wings have no house so they point at the row of the target
+	wing := Hint{Eliminator: "XY-Wing", Loc: Loc{X: 0, Y: 3}}
+	assert.Equal(t, "Look for XY-Wing in row 3", wing.AtLevel(HintHouse).Message)
+}
+
+func TestHintAt(t *testing.T) {
+	g := &Game{}
+	require.NoError(t, g.FillBasic(boards.NYTHard7July2025))
+	before, err := json.Marshal(g)
+	require.NoError(t, err)
+
+	for _, level := range []HintLevel{HintTechnique, HintHouse, HintCells} {
+		ok, lh, err := g.HintAt(level)
+		require.NoError(t, err)
+		require.True(t, ok)
+		t.Log(lh.Message)
+	}
+	after, err := json.Marshal(g)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(before), string(after), "only the last level changes the board")
+
+	ok, lh, err := g.HintAt(HintApply)
+	require.NoError(t, err)
+	require.True(t, ok)
+	t.Log(lh.Message)
+	after, err = json.Marshal(g)
+	require.NoError(t, err)
+	assert.NotEqual(t, string(before), string(after))
+}
@@ -0,0 +1,141 @@
+package sudoku
+
+import "fmt"
+
+// History records the changes made to the cells of a game so they can be undone and redone.
+type History struct {
+	Steps    []Step `json:"steps"`
+	Position int    `json:"position"` // Number of steps applied, the steps after it were undone and can be redone
+
+	recording *Step
+}
+
+// Step is every change made by one action, like applying a hint or setting a cell.
+type Step struct {
+	Source  string       `json:"source"` // The eliminator or action that made the changes
+	Changes []CellChange `json:"changes"`
+}
+
+// CellChange is a reversible change to one cell.
+type CellChange struct {
+	Loc    Loc       `json:"loc"`
+	Action string    `json:"action"` // "set", "removeCandidates", "setValue" or "restore"
+	Before CellState `json:"before"`
+	After  CellState `json:"after"`
+}
+
+// CellState is the part of a cell the history restores.
+type CellState struct {
+	Value      string   `json:"value"`
+	Candidates []string `json:"candidates"`
+}
+
+func (c *Cell) state() CellState {
+	return CellState{Value: c.Value, Candidates: c.Candidates()}
+}
+
+func (c *Cell) restore(s CellState) {
+	c.Value = s.Value
+	c.SetCandidates(s.Candidates...)
+	c.RecentCandidates = nil
+}
+
+// record adds a change to the history of the cell. Changes made outside Game.Record get a step of their own.
+func (c *Cell) record(action string, before CellState) {
+	h := c.history
+	if h == nil {
+		return
+	}
+	change := CellChange{Loc: c.loc, Action: action, Before: before, After: c.state()}
+	if h.recording != nil {
+		h.recording.Changes = append(h.recording.Changes, change)
+		return
+	}
+	h.add(Step{Source: action, Changes: []CellChange{change}})
+}
+
+// add appends a step, dropping the steps that were undone.
+func (h *History) add(s Step) {
+	h.Steps = append(h.Steps[:h.Position], s)
+	h.Position++
+}
+
+// trackHistory starts recording the changes to every cell of the game.
+func (g *Game) trackHistory() {
+	g.History = &History{}
+	for y := range g.Board {
+		for x := range g.Board[y] {
+			cell := g.Board[y][x].Cell
+			cell.loc = Loc{X: x, Y: y}
+			cell.history = g.History
+		}
+	}
+}
+
+// Record runs change and keeps every cell it changes as one step named by source. Records inside of it join its step.
+func (g *Game) Record(source string, change func() error) error {
+	h := g.History
+	if h == nil || h.recording != nil {
+		return change()
+	}
+
+	h.recording = &Step{Source: source}
+	defer func() {
+		step := h.recording
+		h.recording = nil
+		if len(step.Changes) > 0 {
+			h.add(*step)
+		}
+	}()
+	return change()
+}
+
+// nameStep renames the step being recorded, for actions that only know their source once they are done.
+func (g *Game) nameStep(source string) {
+	if g.History != nil && g.History.recording != nil && source != "" {
+		g.History.recording.Source = source
+	}
+}
+
+// Undo reverts the last step. It is false when there is nothing to undo.
+func (g *Game) Undo() bool {
+	if g.History == nil || g.History.Position == 0 {
+		return false
+	}
+	return g.GoToStep(g.History.Position-1) == nil
+}
+
+// Redo applies the last step that was undone. It is false when there is nothing to redo.
+func (g *Game) Redo() bool {
+	if g.History == nil || g.History.Position == len(g.History.Steps) {
+		return false
+	}
+	return g.GoToStep(g.History.Position+1) == nil
+}
+
+// GoToStep undoes or redoes steps until n steps are applied. Zero goes back to the puzzle as it was loaded.
+func (g *Game) GoToStep(n int) error {
+	h := g.History
+	if h == nil {
+		return fmt.Errorf("game does not have a history")
+	}
+	if n < 0 || n > len(h.Steps) {
+		return fmt.Errorf("step %d is not between 0 and %d", n, len(h.Steps))
+	}
+
+	for h.Position > n {
+		h.Position--
+		changes := h.Steps[h.Position].Changes
+		for i := len(changes) - 1; i >= 0; i-- {
+			g.Board[changes[i].Loc.Y][changes[i].Loc.X].Cell.restore(changes[i].Before)
+		}
+	}
+	for h.Position < n {
+		for _, c := range h.Steps[h.Position].Changes {
+			g.Board[c.Loc.Y][c.Loc.X].Cell.restore(c.After)
+		}
+		h.Position++
+	}
+	g.Solved = g.Won()
+	return nil
+}
@@ -0,0 +1,88 @@
+package sudoku
+
+import (
+	"testing"
+
+	"github.com/mvndaai/sudoku_hints/sudoku/boards"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// cellStates returns the value and candidates of every cell.
+func cellStates(g *Game) [][]CellState {
+	states := make([][]CellState, len(g.Board))
+	for y := range g.Board {
+		for _, gc := range g.Board[y] {
+			states[y] = append(states[y], gc.Cell.state())
+		}
+	}
+	return states
+}
+
+func TestHistory(t *testing.T) {
+	g := &Game{}
+	require.NoError(t, g.FillBasic(boards.NYTHard7July2025))
+	require.NotNil(t, g.History)
+	assert.Zero(t, g.History.Position, "filling the puzzle is not a step")
+	assert.False(t, g.Undo())
+
+	states := [][][]CellState{cellStates(g)}
+	ok, h, err := g.RemoveOneCandidate(true)
+	require.NoError(t, err)
+	require.True(t, ok)
+	states = append(states, cellStates(g))
+
+	solution, err := g.Solve()
+	require.NoError(t, err)
+	err = g.Record("Set Cell", func() error {
+		require.NoError(t, g.SetValue(0, 1, solution[0][1]))
+		return g.RemoveAllSimple(false)
+	})
+	require.NoError(t, err)
+	states = append(states, cellStates(g))
+
+	require.Len(t, g.History.Steps, 2)
+	assert.Equal(t, h.Eliminator, g.History.Steps[0].Source)
+	assert.Equal(t, "Set Cell", g.History.Steps[1].Source)
+	assert.Equal(t, "setValue", g.History.Steps[1].Changes[0].Action)
+	assert.Greater(t, len(g.History.Steps[1].Changes), 1, "the nested removals join the step")
+
+	require.True(t, g.Undo())
+	assert.Equal(t, states[1], cellStates(g))
+	require.True(t, g.Undo())
+	assert.Equal(t, states[0], cellStates(g))
+	assert.False(t, g.Undo())
+
+	require.True(t, g.Redo())
+	assert.Equal(t, states[1], cellStates(g))
+	require.NoError(t, g.GoToStep(2))
+	assert.Equal(t, states[2], cellStates(g))
+	assert.False(t, g.Redo())
+	assert.Error(t, g.GoToStep(3))
+
+	// A new change drops the steps that were undone
+	require.NoError(t, g.GoToStep(0))
+	g.Board[0][1].Cell.Set("1")
+	require.Len(t, g.History.Steps, 1)
+	assert.Equal(t, "set", g.History.Steps[0].Source)
+	assert.Equal(t, 1, g.History.Position)
+}
+
+func TestHistoryIgnoresClones(t *testing.T) {
+	g := emptyGame(t)
+	c := g.clone()
+	c.Board[0][0].Cell.Set("1")
+	c.Board[0][1].Cell.RemoveCandiates([]string{"1"})
+	assert.Empty(t, g.History.Steps)
+	assert.Nil(t, c.History)
+}
+
+func TestHistoryNamesEliminatorSteps(t *testing.T) {
+	g := &Game{}
+	require.NoError(t, g.FillBasic(boards.NYTHard7July2025))
+
+	change, err := g.EliminateCandidates(false)
+	require.NoError(t, err)
+	require.Len(t, g.History.Steps, 1)
+	assert.Contains(t, change, "("+g.History.Steps[0].Source+")")
+}
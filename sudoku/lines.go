@@ -0,0 +1,335 @@
+package sudoku
+
+import (
+	"fmt"
+	"math"
+	"slices"
+)
+
+// Types of the line constraints a game can have in Game.Lines.
+const (
+	LineThermometer    = "thermometer"     // Values strictly increase from the bulb, the first cell
+	LineArrow          = "arrow"           // The circle, the first cell, equals the sum of the rest of the arrow
+	LineGermanWhispers = "german whispers" // Neighbors on the line differ by at least 5
+	LineRenban         = "renban"          // The line holds a set of consecutive values in any order
+	LinePalindrome     = "palindrome"      // The line reads the same from both ends
+	LineRegionSum      = "region sum"      // Every part of the line inside one group adds up to the same sum
+)
+
+// germanWhispersDifference is the least difference between neighbors on a German whispers line.
+const germanWhispersDifference = 5
+
+// Line is a line constraint drawn through cells in order.
+type Line struct {
+	Type  string `json:"type"`
+	Cells []Loc  `json:"cells"`
+}
+
+// distinct reports whether the cells of the line can never repeat a value.
+func (l Line) distinct() bool {
+	return l.Type == LineThermometer || l.Type == LineRenban
+}
+
+// lineRule returns the numbers each cell of a line can hold, given the sorted numbers every cell can hold now and the
+// group of every cell. A cell left without numbers means the line cannot be filled.
+type lineRule func(options [][]int, regions []int) [][]int
+
+var lineRules = map[string]lineRule{
+	LineThermometer:    thermometerRule,
+	LineArrow:          arrowRule,
+	LineGermanWhispers: germanWhispersRule,
+	LineRenban:         renbanRule,
+	LinePalindrome:     palindromeRule,
+	LineRegionSum:      regionSumRule,
+}
+
+func thermometerRule(options [][]int, _ []int) [][]int {
+	keep := slices.Clone(options)
+	low := math.MinInt
+	for i := range keep {
+		keep[i] = slices.DeleteFunc(slices.Clone(keep[i]), func(n int) bool { return n <= low })
+		if len(keep[i]) == 0 {
+			return keep
+		}
+		low = keep[i][0]
+	}
+	high := math.MaxInt
+	for i := len(keep) - 1; i >= 0; i-- {
+		keep[i] = slices.DeleteFunc(keep[i], func(n int) bool { return n >= high })
+		if len(keep[i]) == 0 {
+			return keep
+		}
+		high = keep[i][len(keep[i])-1]
+	}
+	return keep
+}
+
+func arrowRule(options [][]int, _ []int) [][]int {
+	keep := slices.Clone(options)
+	low, high := sumRange(options[1:])
+	keep[0] = slices.DeleteFunc(slices.Clone(keep[0]), func(n int) bool { return n < low || n > high })
+	if len(keep[0]) == 0 {
+		return keep
+	}
+	least, most := keep[0][0], keep[0][len(keep[0])-1]
+	for i := 1; i < len(keep); i++ {
+		keep[i] = keepWithin(keep[i], low, high, least, most)
+	}
+	return keep
+}
+
+func germanWhispersRule(options [][]int, _ []int) [][]int {
+	keep := slices.Clone(options)
+	far := func(n int, others []int) bool {
+		return slices.ContainsFunc(others, func(o int) bool { return abs(n-o) >= germanWhispersDifference })
+	}
+	for i := range keep {
+		keep[i] = slices.DeleteFunc(slices.Clone(keep[i]), func(n int) bool {
+			return (i > 0 && !far(n, options[i-1])) || (i < len(options)-1 && !far(n, options[i+1]))
+		})
+	}
+	return keep
+}
+
+func renbanRule(options [][]int, _ []int) [][]int {
+	size := len(options)
+	fits := func(start int) bool {
+		return !slices.ContainsFunc(options, func(o []int) bool {
+			return !slices.ContainsFunc(o, func(n int) bool { return n >= start && n < start+size })
+		})
+	}
+	keep := slices.Clone(options)
+	for i := range keep {
+		keep[i] = slices.DeleteFunc(slices.Clone(keep[i]), func(n int) bool {
+			for start := n - size + 1; start <= n; start++ {
+				if fits(start) {
+					return false
+				}
+			}
+			return true
+		})
+	}
+	return keep
+}
+
+func palindromeRule(options [][]int, _ []int) [][]int {
+	keep := slices.Clone(options)
+	for i := range keep {
+		mirror := options[len(options)-1-i]
+		keep[i] = slices.DeleteFunc(slices.Clone(keep[i]), func(n int) bool { return !slices.Contains(mirror, n) })
+	}
+	return keep
+}
+
+func regionSumRule(options [][]int, regions []int) [][]int {
+	// Split the line where it crosses from one group to another
+	segments := [][]int{{0}}
+	for i := 1; i < len(options); i++ {
+		if regions[i] == regions[i-1] {
+			segments[len(segments)-1] = append(segments[len(segments)-1], i)
+		} else {
+			segments = append(segments, []int{i})
+		}
+	}
+	keep := slices.Clone(options)
+	if len(segments) < 2 {
+		return keep
+	}
+
+	least, most := math.MinInt, math.MaxInt
+	for _, segment := range segments {
+		low, high := sumRange(pick(options, segment))
+		least, most = max(least, low), min(most, high)
+	}
+	for _, segment := range segments {
+		low, high := sumRange(pick(options, segment))
+		for _, i := range segment {
+			keep[i] = keepWithin(keep[i], low, high, least, most)
+		}
+	}
+	return keep
+}
+
+// sumRange returns the least and most the cells can add up to.
+func sumRange(options [][]int) (low, high int) {
+	for _, o := range options {
+		if len(o) == 0 {
+			continue
+		}
+		low += o[0]
+		high += o[len(o)-1]
+	}
+	return low, high
+}
+
+// keepWithin keeps the numbers of one cell that let the cells it is summed with, whose sum is between low and high,
+// add up to something between least and most.
+func keepWithin(option []int, low, high, least, most int) []int {
+	if len(option) == 0 {
+		return option
+	}
+	lowOthers, highOthers := low-option[0], high-option[len(option)-1]
+	return slices.DeleteFunc(slices.Clone(option), func(n int) bool { return n+lowOthers > most || n+highOthers < least })
+}
+
+func pick(options [][]int, indexes []int) [][]int {
+	picked := make([][]int, 0, len(indexes))
+	for _, i := range indexes {
+		picked = append(picked, options[i])
+	}
+	return picked
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// checkLines makes sure the lines are known, on the board, do not cross themselves and can be compared.
+func (g *Game) checkLines() error {
+	if len(g.Lines) == 0 {
+		return nil
+	}
+	if err := g.checkSymbolNumbers(); err != nil {
+		return fmt.Errorf("lines need numbers: %w", err)
+	}
+	for i, line := range g.Lines {
+		if _, ok := lineRules[line.Type]; !ok {
+			return fmt.Errorf("line %d has unknown type '%s'", i, line.Type)
+		}
+		if len(line.Cells) < 2 {
+			return fmt.Errorf("line %d needs at least 2 cells", i)
+		}
+		if line.distinct() && len(line.Cells) > len(g.Symbols) {
+			return fmt.Errorf("%s line %d has more cells than symbols", line.Type, i)
+		}
+		for j, l := range line.Cells {
+			if !g.OnBoard(l) {
+				return fmt.Errorf("line %d has %v outside of the board", i, l)
+			}
+			if slices.Contains(line.Cells[:j], l) {
+				return fmt.Errorf("line %d has %v twice", i, l)
+			}
+		}
+	}
+	return nil
+}
+
+// lineCells returns the cells of each line, in the order of Game.Lines.
+func (g *Game) lineCells() [][]LocCell {
+	lines := make([][]LocCell, len(g.Lines))
+	for i, line := range g.Lines {
+		lines[i] = g.locCells(line.Cells)
+	}
+	return lines
+}
+
+// lineOptions returns the numbers each cell of the line can hold by its rule, along with the numbers of the value or
+// candidates of each cell.
+func (g *Game) lineOptions(line Line, cells []LocCell) (keep, options [][]int) {
+	options = make([][]int, len(cells))
+	regions := make([]int, len(cells))
+	for i, lc := range cells {
+		symbols := lc.Cell.Candidates()
+		if lc.Cell.Value != "" {
+			symbols = []string{lc.Cell.Value}
+		}
+		for _, s := range symbols {
+			if n, err := g.symbolNumber(s); err == nil {
+				options[i] = append(options[i], n)
+			}
+		}
+		slices.Sort(options[i])
+		regions[i] = g.groupOf(lc.Loc)
+	}
+	return lineRules[line.Type](options, regions), options
+}
+
+// checkLineRules returns an error for the first line that cannot be filled.
+func (g *Game) checkLineRules() error {
+	for i, cells := range g.lineCells() {
+		keep, _ := g.lineOptions(g.Lines[i], cells)
+		if slices.ContainsFunc(keep, func(k []int) bool { return len(k) == 0 }) {
+			return fmt.Errorf("%s line %d cannot be filled", g.Lines[i].Type, i)
+		}
+	}
+	return nil
+}
+
+// LineHint describes the line constraint that makes a hint.
+type LineHint struct {
+	Type  string `json:"type"`
+	Line  int    `json:"line"` // Index in Game.Lines
+	Cells []Loc  `json:"cells"`
+}
+
+func (l LineHint) String() string {
+	return fmt.Sprintf("because of %s line %d", l.Type, l.Line)
+}
+
+func (l LineHint) explain(g *Game) ([]Cause, []House) {
+	return g.causes(l.Cells), []House{{Type: "line", Index: l.Line}}
+}
+
+// lineEliminator removes the candidates the rule of every line of one type does not allow.
+func lineEliminator(lineType, name, description string, weight float64) CandidateEliminator {
+	r := CandidateEliminator{
+		Name:        name,
+		Description: description,
+		Weight:      weight,
+		GameHinter: func(g *Game) (bool, Hint, error) {
+			for i, cells := range g.lineCells() {
+				line := g.Lines[i]
+				if line.Type != lineType {
+					continue
+				}
+				keep, _ := g.lineOptions(line, cells)
+				if slices.ContainsFunc(keep, func(k []int) bool { return len(k) == 0 }) {
+					continue // BadBoard reports lines that cannot be filled
+				}
+				for j, lc := range cells {
+					if lc.Cell.Value != "" {
+						continue
+					}
+					remove := slices.DeleteFunc(lc.Cell.Candidates(), func(s string) bool {
+						n, err := g.symbolNumber(s)
+						return err == nil && slices.Contains(keep[j], n)
+					})
+					if len(remove) > 0 {
+						return true, Hint{
+							Loc:                lc.Loc,
+							Eliminator:         name,
+							CandidatesToRemove: remove,
+							cell:               lc.Cell,
+							Line:               &LineHint{Type: line.Type, Line: i, Cells: line.Cells},
+						}, nil
+					}
+				}
+			}
+			return false, Hint{}, nil
+		},
+	}
+
+	r.GameEliminator = GameHinterToEliminator(r.GameHinter)
+	return r
+}
+
+var EliminatorThermometer = lineEliminator(LineThermometer, "Thermometer",
+	"Values on a thermometer increase from the bulb, so each cell has to leave room for the cells before and after it.", 1.4)
+
+var EliminatorArrow = lineEliminator(LineArrow, "Arrow",
+	"The circle of an arrow equals the sum of the cells on the arrow, so the circle and the arrow limit each other.", 1.6)
+
+var EliminatorGermanWhispers = lineEliminator(LineGermanWhispers, "German Whispers",
+	"Neighbors on a German whispers line differ by at least 5, so a cell can only hold values far enough from a value its neighbors can hold.", 1.4)
+
+var EliminatorRenban = lineEliminator(LineRenban, "Renban",
+	"A renban line holds consecutive values in any order, so a cell can only hold values that fit a run every cell of the line can take part in.", 1.6)
+
+var EliminatorPalindrome = lineEliminator(LinePalindrome, "Palindrome",
+	"A palindrome line reads the same from both ends, so cells the same distance from either end share their candidates.", 1.2)
+
+var EliminatorRegionSum = lineEliminator(LineRegionSum, "Region Sum Line",
+	"Every part of a region sum line inside one group adds up to the same sum, so each part limits the sums of the others.", 1.8)
@@ -0,0 +1,164 @@
+package sudoku
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLineRules(t *testing.T) {
+	digits := []int{1, 2, 3, 4, 5, 6, 7, 8, 9}
+	tests := []struct {
+		name    string
+		rule    lineRule
+		options [][]int
+		regions []int
+		want    [][]int
+	}{
+		{
+			name:    "thermometer",
+			rule:    thermometerRule,
+			options: [][]int{digits, digits, {3, 4, 8}, digits},
+			want:    [][]int{{1, 2, 3, 4, 5, 6}, {2, 3, 4, 5, 6, 7}, {3, 4, 8}, {4, 5, 6, 7, 8, 9}},
+		},
+		{
+			name:    "arrow",
+			rule:    arrowRule,
+			options: [][]int{digits, {5, 6}, digits},
+			want:    [][]int{{6, 7, 8, 9}, {5, 6}, {1, 2, 3, 4}},
+		},
+		{
+			name:    "german whispers",
+			rule:    germanWhispersRule,
+			options: [][]int{digits, {3}, digits},
+			want:    [][]int{{8, 9}, {3}, {8, 9}},
+		},
+		{
+			name:    "renban",
+			rule:    renbanRule,
+			options: [][]int{{1, 9}, digits, {2, 3}},
+			want:    [][]int{{1}, {1, 2, 3}, {2, 3}},
+		},
+		{
+			name:    "palindrome",
+			rule:    palindromeRule,
+			options: [][]int{{1, 2, 3}, digits, {2, 3, 4}},
+			want:    [][]int{{2, 3}, digits, {2, 3}},
+		},
+		{
+			name:    "region sum",
+			rule:    regionSumRule,
+			options: [][]int{{1, 2}, {1, 2}, digits},
+			regions: []int{0, 0, 1},
+			want:    [][]int{{1, 2}, {1, 2}, {2, 3, 4}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			regions := tt.regions
+			if regions == nil {
+				regions = make([]int, len(tt.options))
+			}
+			assert.Equal(t, tt.want, tt.rule(tt.options, regions))
+		})
+	}
+}
+
+func TestThermometer(t *testing.T) {
+	g := &Game{Lines: []Line{{Type: LineThermometer, Cells: []Loc{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 2, Y: 0}, {X: 3, Y: 0}}}}}
+	require.NoError(t, g.FillBasic(emptyCells()))
+	assert.True(t, g.Sees(Loc{X: 0, Y: 0}, Loc{X: 3, Y: 0}))
+
+	ok, h, err := g.findHint([]CandidateEliminator{EliminatorThermometer})
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, Loc{X: 0, Y: 0}, h.Loc)
+	assert.Equal(t, []string{"7", "8", "9"}, h.CandidatesToRemove)
+	assert.Equal(t, "because of thermometer line 0", h.Line.String())
+	require.Len(t, h.Houses, 1)
+	assert.Equal(t, "thermometer line 0", h.Houses[0].Name)
+
+	collectChanges(t, g, EliminatorThermometer)
+	assert.Equal(t, []string{"4", "5", "6", "7", "8", "9"}, g.Board[0][3].Cell.Candidates())
+
+	err = g.SetValue(0, 2, "2")
+	require.Error(t, err, "the first two cells cannot both be below 2")
+	assert.Contains(t, err.Error(), "thermometer line 0 cannot be filled")
+}
+
+func TestLineSolve(t *testing.T) {
+	lines := []Line{
+		{Type: LineThermometer, Cells: []Loc{{X: 0, Y: 0}, {X: 0, Y: 1}, {X: 1, Y: 2}, {X: 2, Y: 3}}},
+		{Type: LineGermanWhispers, Cells: []Loc{{X: 4, Y: 0}, {X: 5, Y: 1}, {X: 6, Y: 2}, {X: 7, Y: 3}}},
+		{Type: LineArrow, Cells: []Loc{{X: 8, Y: 8}, {X: 7, Y: 8}, {X: 6, Y: 8}}},
+		{Type: LineRenban, Cells: []Loc{{X: 0, Y: 5}, {X: 1, Y: 5}, {X: 2, Y: 5}, {X: 3, Y: 5}}},
+		{Type: LinePalindrome, Cells: []Loc{{X: 1, Y: 6}, {X: 2, Y: 7}, {X: 3, Y: 8}, {X: 4, Y: 8}, {X: 5, Y: 7}}},
+		{Type: LineRegionSum, Cells: []Loc{{X: 4, Y: 4}, {X: 5, Y: 4}, {X: 6, Y: 4}, {X: 7, Y: 4}}},
+	}
+	g := &Game{Lines: lines}
+	require.NoError(t, g.FillBasic(emptyCells()))
+	solution, err := g.Solve()
+	require.NoError(t, err)
+
+	number := func(l Loc) int {
+		n, err := strconv.Atoi(solution[l.Y][l.X])
+		require.NoError(t, err)
+		return n
+	}
+	for _, line := range lines {
+		options := [][]int{}
+		regions := []int{}
+		for _, l := range line.Cells {
+			options = append(options, []int{number(l)})
+			regions = append(regions, g.groupOf(l))
+		}
+		assert.Equal(t, options, lineRules[line.Type](options, regions), "%s line is broken", line.Type)
+	}
+}
+
+func TestSymbolValues(t *testing.T) {
+	group := map[Loc]int{}
+	for y := range 4 {
+		for x := range 4 {
+			group[Loc{X: x, Y: y}] = y/2*2 + x/2
+		}
+	}
+	cells := [][]string{{"", "", "", ""}, {"", "", "", ""}, {"", "", "", ""}, {"", "", "", ""}}
+
+	g := &Game{
+		SymbolValues: map[string]int{"A": 1, "B": 2, "C": 3, "D": 4},
+		Lines:        []Line{{Type: LineThermometer, Cells: []Loc{{X: 3, Y: 0}, {X: 2, Y: 0}, {X: 1, Y: 0}, {X: 0, Y: 0}}}},
+	}
+	require.NoError(t, g.Fill(cells, group, []string{"A", "B", "C", "D"}))
+	solution, err := g.Solve()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"D", "C", "B", "A"}, solution[0])
+
+	collectChanges(t, g, EliminatorThermometer)
+	assert.Equal(t, []string{"A"}, g.Board[0][3].Cell.Candidates())
+
+	g = &Game{SymbolValues: map[string]int{"A": 1, "B": 1, "C": 3, "D": 4}, Lines: g.Lines}
+	err = g.Fill(cells, group, []string{"A", "B", "C", "D"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "both stand for 1")
+}
+
+func TestCheckLines(t *testing.T) {
+	tests := []struct {
+		name string
+		line Line
+	}{
+		{name: "unknown type", line: Line{Type: "zipper", Cells: []Loc{{X: 0, Y: 0}, {X: 1, Y: 0}}}},
+		{name: "one cell", line: Line{Type: LineArrow, Cells: []Loc{{X: 0, Y: 0}}}},
+		{name: "outside of the board", line: Line{Type: LineArrow, Cells: []Loc{{X: 0, Y: 0}, {X: 0, Y: 9}}}},
+		{name: "crosses itself", line: Line{Type: LinePalindrome, Cells: []Loc{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 0, Y: 0}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &Game{Lines: []Line{tt.line}}
+			assert.Error(t, g.FillBasic(emptyCells()))
+		})
+	}
+}
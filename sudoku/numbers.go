@@ -0,0 +1,39 @@
+package sudoku
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// symbolNumber returns the number a symbol stands for when cells are added up or compared, from Game.SymbolValues or
+// by reading the symbol as a number.
+func (g *Game) symbolNumber(symbol string) (int, error) {
+	if g.SymbolValues != nil {
+		n, ok := g.SymbolValues[symbol]
+		if !ok {
+			return 0, fmt.Errorf("symbol '%s' has no value", symbol)
+		}
+		return n, nil
+	}
+	n, err := strconv.Atoi(symbol)
+	if err != nil {
+		return 0, fmt.Errorf("symbol '%s' is not a number", symbol)
+	}
+	return n, nil
+}
+
+// checkSymbolNumbers makes sure every symbol stands for a different number.
+func (g *Game) checkSymbolNumbers() error {
+	seen := map[int]string{}
+	for _, s := range g.Symbols {
+		n, err := g.symbolNumber(s)
+		if err != nil {
+			return err
+		}
+		if other, ok := seen[n]; ok {
+			return fmt.Errorf("symbols '%s' and '%s' both stand for %d", other, s, n)
+		}
+		seen[n] = s
+	}
+	return nil
+}
@@ -59,5 +59,7 @@ func ProcessImage(key string, filename string, file []byte) (Game, error) {
 	if err != nil {
 		return Game{}, err
 	}
+	g.Warning = g.SolutionWarning()
+	g.SetRating()
 	return g, nil
 }
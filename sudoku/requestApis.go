@@ -37,5 +37,7 @@ func RequestDosukoPuzzle() (Game, error) {
 	g := Game{}
 	g.FillBasic(puzzleResponse.Newboard.Grids[0].Value)
 	g.Difficulty = puzzleResponse.Newboard.Grids[0].Difficulty
+	g.Warning = g.SolutionWarning()
+	g.SetRating()
 	return g, nil
 }
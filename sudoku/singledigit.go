@@ -0,0 +1,284 @@
+package sudoku
+
+import (
+	"fmt"
+	"slices"
+)
+
+// StrongLink is a pair of cells that are the only two places for a symbol in a house.
+type StrongLink struct {
+	Symbol string `json:"symbol"`
+	House  string `json:"house"` // "row", "column" or "group"
+	Index  int    `json:"index"`
+	A      Loc    `json:"a"`
+	B      Loc    `json:"b"`
+}
+
+func (l StrongLink) String() string {
+	return fmt.Sprintf("%s %d %s=%s", l.House, l.Index, formatLocs([]Loc{l.A}), formatLocs([]Loc{l.B}))
+}
+
+// reversed returns the same link starting from the other cell.
+func (l StrongLink) reversed() StrongLink {
+	l.A, l.B = l.B, l.A
+	return l
+}
+
+// SingleDigitHint describes a pattern of strong links on one symbol.
+type SingleDigitHint struct {
+	Name        string       `json:"name"`
+	Symbol      string       `json:"symbol"`
+	StrongLinks []StrongLink `json:"strongLinks"`
+	Group       *int         `json:"group,omitempty"` // The group holding an empty rectangle
+}
+
+func (s SingleDigitHint) String() string {
+	r := fmt.Sprintf("with %s on %s using", s.Name, s.Symbol)
+	for i, l := range s.StrongLinks {
+		if i != 0 {
+			r += " and"
+		}
+		r += " " + l.String()
+	}
+	if s.Group != nil {
+		r += fmt.Sprintf(" and group %d", *s.Group)
+	}
+	return r
+}
+
+func (s SingleDigitHint) explain(g *Game) ([]Cause, []House) {
+	locs := []Loc{}
+	houses := []House{}
+	for _, l := range s.StrongLinks {
+		locs = append(locs, l.A, l.B)
+		houses = addHouses(houses, House{Type: l.House, Index: l.Index})
+	}
+	if s.Group != nil {
+		houses = append(houses, House{Type: "group", Index: *s.Group})
+	}
+	return g.causes(locs, s.Symbol), houses
+}
+
+// conjugatePairs returns every house where the symbol only has two possible cells.
+func conjugatePairs(symbol string, rows, cols, groups [][]LocCell) []StrongLink {
+	houses := []struct {
+		name  string
+		cells [][]LocCell
+	}{
+		{"row", rows},
+		{"column", cols},
+		{"group", groups},
+	}
+
+	links := []StrongLink{}
+	for _, house := range houses {
+		for i, cells := range house.cells {
+			locs := []Loc{}
+			for _, lc := range cells {
+				if lc.Cell.HasCandidate(symbol) {
+					locs = append(locs, lc.Loc)
+				}
+			}
+			if len(locs) != 2 {
+				continue
+			}
+			links = append(links, StrongLink{Symbol: symbol, House: house.name, Index: i, A: locs[0], B: locs[1]})
+		}
+	}
+	return links
+}
+
+var EliminatorSkyscraper = newSingleDigitEliminator(
+	"Skyscraper",
+	"Two parallel lines each have a symbol in only two cells and one end of each shares a line. One of the other ends must be the symbol, so cells seeing both cannot be.",
+	4.0,
+	func(g *Game, symbol string, links []StrongLink) (bool, Hint) {
+		for i, first := range links {
+			for _, second := range links[i+1:] {
+				if first.House != second.House || first.House == "group" {
+					continue
+				}
+				for _, l1 := range []StrongLink{first, first.reversed()} {
+					for _, l2 := range []StrongLink{second, second.reversed()} {
+						if !sharesLine(first.House, l1.A, l2.A) || sharesLine(first.House, l1.B, l2.B) {
+							continue
+						}
+						if ok, h := g.turbotHint(symbol, l1, l2); ok {
+							return true, h
+						}
+					}
+				}
+			}
+		}
+		return false, Hint{}
+	},
+)
+
+var EliminatorTwoStringKite = newSingleDigitEliminator(
+	"2-String Kite",
+	"A row and a column each have a symbol in only two cells and one end of each shares a group. One of the other ends must be the symbol, so cells seeing both cannot be.",
+	4.1,
+	func(g *Game, symbol string, links []StrongLink) (bool, Hint) {
+		for _, first := range links {
+			if first.House != "row" {
+				continue
+			}
+			for _, second := range links {
+				if second.House != "column" {
+					continue
+				}
+				for _, l1 := range []StrongLink{first, first.reversed()} {
+					for _, l2 := range []StrongLink{second, second.reversed()} {
+						if l1.A == l2.A || g.groupOf(l1.A) != g.groupOf(l2.A) {
+							continue
+						}
+						if ok, h := g.turbotHint(symbol, l1, l2); ok {
+							return true, h
+						}
+					}
+				}
+			}
+		}
+		return false, Hint{}
+	},
+)
+
+var EliminatorEmptyRectangle = newSingleDigitEliminator(
+	"Empty Rectangle",
+	"A group where a symbol only fits on one row and one column, combined with a line that has the symbol in only two cells, removes the symbol where they cross.",
+	4.2,
+	func(g *Game, symbol string, links []StrongLink) (bool, Hint) {
+		_, _, groups := g.GetSectionedCells()
+		for group, cells := range groups {
+			locs := []Loc{}
+			for _, lc := range cells {
+				if lc.Cell.HasCandidate(symbol) {
+					locs = append(locs, lc.Loc)
+				}
+			}
+			if len(locs) < 2 {
+				continue
+			}
+
+			for _, row := range locs {
+				for _, col := range locs {
+					r, c := row.Y, col.X
+					if !isEmptyRectangle(locs, r, c) {
+						continue
+					}
+					for _, link := range links {
+						for _, l := range []StrongLink{link, link.reversed()} {
+							if g.groupOf(l.A) == group || g.groupOf(l.B) == group {
+								continue
+							}
+							var target Loc
+							switch {
+							case l.House == "column" && l.A.X != c && l.A.Y == r:
+								target = Loc{X: c, Y: l.B.Y}
+							case l.House == "row" && l.A.Y != r && l.A.X == c:
+								target = Loc{X: l.B.X, Y: r}
+							default:
+								continue
+							}
+							cell := g.Board[target.Y][target.X].Cell
+							if g.groupOf(target) == group || target == l.B || !cell.HasCandidate(symbol) {
+								continue
+							}
+							return true, Hint{
+								Loc:                target,
+								CandidatesToRemove: []string{symbol},
+								cell:               cell,
+								SingleDigit: &SingleDigitHint{
+									Symbol:      symbol,
+									StrongLinks: []StrongLink{l},
+									Group:       &group,
+								},
+							}
+						}
+					}
+				}
+			}
+		}
+		return false, Hint{}
+	},
+)
+
+// isEmptyRectangle reports whether the locations all sit on row r or column c, with some on each outside their crossing.
+func isEmptyRectangle(locs []Loc, r, c int) bool {
+	onRow, onCol := false, false
+	for _, l := range locs {
+		switch {
+		case l.Y == r && l.X == c:
+		case l.Y == r:
+			onRow = true
+		case l.X == c:
+			onCol = true
+		default:
+			return false
+		}
+	}
+	return onRow && onCol
+}
+
+// sharesLine reports whether two cells share the line perpendicular to the house type, e.g. the column for two row links.
+func sharesLine(house string, a, b Loc) bool {
+	if house == "row" {
+		return a.X == b.X
+	}
+	return a.Y == b.Y
+}
+
+// turbotHint removes the symbol from cells seeing the far ends of two strong links whose near ends see each other.
+func (g *Game) turbotHint(symbol string, l1, l2 StrongLink) (bool, Hint) {
+	used := []Loc{l1.A, l1.B, l2.A, l2.B}
+	slices.SortFunc(used, func(a, b Loc) int {
+		if a.Y != b.Y {
+			return a.Y - b.Y
+		}
+		return a.X - b.X
+	})
+	if len(slices.Compact(used)) != 4 {
+		return false, Hint{}
+	}
+
+	for _, lc := range g.CommonPeers([]Loc{l1.B, l2.B}) {
+		if !lc.Cell.HasCandidate(symbol) || slices.Contains(used, lc.Loc) {
+			continue
+		}
+		return true, Hint{
+			Loc:                lc.Loc,
+			CandidatesToRemove: []string{symbol},
+			cell:               lc.Cell,
+			SingleDigit: &SingleDigitHint{
+				Symbol:      symbol,
+				StrongLinks: []StrongLink{l1, l2},
+			},
+		}
+	}
+	return false, Hint{}
+}
+
+type singleDigitFinder func(g *Game, symbol string, links []StrongLink) (bool, Hint)
+
+func newSingleDigitEliminator(name, description string, weight float64, find singleDigitFinder) CandidateEliminator {
+	r := CandidateEliminator{
+		Name:        name,
+		Description: description,
+		Weight:      weight,
+		GameHinter: func(g *Game) (bool, Hint, error) {
+			rows, cols, groups := g.GetSectionedCells()
+			for _, symbol := range g.Symbols {
+				links := conjugatePairs(symbol, rows, cols, groups)
+				if ok, h := find(g, symbol, links); ok {
+					h.Eliminator = name
+					h.SingleDigit.Name = name
+					return true, h, nil
+				}
+			}
+			return false, Hint{}, nil
+		},
+	}
+
+	r.GameEliminator = GameHinterToEliminator(r.GameHinter)
+	return r
+}
@@ -0,0 +1,106 @@
+package sudoku
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConjugatePairs(t *testing.T) {
+	g := emptyGame(t)
+	keepSymbol(g, "5", []string{
+		"xxxxxxxxx",
+		"..x...x..",
+		"xxxxxxxxx",
+		"xxxxxxxxx",
+		"xxxxxxxxx",
+		"xxxxxxxxx",
+		"xxxxxxxxx",
+		"xxxxxxxxx",
+		"xxxxxxxxx",
+	})
+
+	rows, cols, groups := g.GetSectionedCells()
+	assert.Equal(t, []StrongLink{
+		{Symbol: "5", House: "row", Index: 1, A: Loc{X: 2, Y: 1}, B: Loc{X: 6, Y: 1}},
+	}, conjugatePairs("5", rows, cols, groups))
+	assert.Empty(t, conjugatePairs("4", rows, cols, groups))
+}
+
+func TestEliminatorSingleDigit(t *testing.T) {
+	tests := []struct {
+		name       string
+		eliminator CandidateEliminator
+		pattern    []string
+		expected   []string
+	}{
+		{
+			name:       "Skyscraper",
+			eliminator: EliminatorSkyscraper,
+			pattern: []string{
+				"xxxxxxxxx",
+				"..x...x..",
+				"xxxxxxxxx",
+				"xxxxxxxxx",
+				"xxxxxxxxx",
+				"..x....x.",
+				"xxxxxxxxx",
+				"xxxxxxxxx",
+				"xxxxxxxxx",
+			},
+			expected: []string{
+				"removed candidates (x:7,y:0) [5] with Skyscraper on 5 using row 1 (x:2,y:1)=(x:6,y:1) and row 5 (x:2,y:5)=(x:7,y:5)",
+				"removed candidates (x:7,y:2) [5] with Skyscraper on 5 using row 1 (x:2,y:1)=(x:6,y:1) and row 5 (x:2,y:5)=(x:7,y:5)",
+				"removed candidates (x:6,y:3) [5] with Skyscraper on 5 using row 1 (x:2,y:1)=(x:6,y:1) and row 5 (x:2,y:5)=(x:7,y:5)",
+				"removed candidates (x:6,y:4) [5] with Skyscraper on 5 using row 1 (x:2,y:1)=(x:6,y:1) and row 5 (x:2,y:5)=(x:7,y:5)",
+			},
+		},
+		{
+			name:       "2-String Kite",
+			eliminator: EliminatorTwoStringKite,
+			pattern: []string{
+				".xxxxxxxx",
+				".x....x..",
+				"xxxxxxxxx",
+				".xxxxxxxx",
+				".xxxxxxxx",
+				".xxxxxxxx",
+				".xxxxxxxx",
+				"xxxxxxxxx",
+				".xxxxxxxx",
+			},
+			expected: []string{
+				"removed candidates (x:6,y:7) [5] with 2-String Kite on 5 using row 1 (x:1,y:1)=(x:6,y:1) and column 0 (x:0,y:2)=(x:0,y:7)",
+			},
+		},
+		{
+			name:       "Empty Rectangle",
+			eliminator: EliminatorEmptyRectangle,
+			pattern: []string{
+				"x.xxxxxxx",
+				"x.xxxxxxx",
+				"x.xxxxxxx",
+				"x.x.x.xxx",
+				"xxxxxxxxx",
+				"x.x.x.xxx",
+				"x.xxxxxxx",
+				"x.xxxxxxx",
+				"xxxxxxxxx",
+			},
+			expected: []string{
+				"removed candidates (x:4,y:8) [5] with Empty Rectangle on 5 using column 1 (x:1,y:4)=(x:1,y:8) and group 4",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := emptyGame(t)
+			keepSymbol(g, "5", tt.pattern)
+
+			assert.ElementsMatch(t, tt.expected, collectChanges(t, g, tt.eliminator))
+			require.NoError(t, g.BadBoard())
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package sudoku
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Snapshot is the values and candidates of every cell, stored as symbol indexes so many of them can be kept.
+type Snapshot struct {
+	values     []int8         // Index of the value of each cell in the symbols of the cell, -1 when empty
+	candidates []CandidateSet // The candidates of each cell, as the cell stores them
+	width      int
+	symbols    int
+}
+
+// Snapshot saves the values and candidates of the board so they can be put back with Restore.
+func (g *Game) Snapshot() (Snapshot, error) {
+	if len(g.Symbols) > 64 {
+		return Snapshot{}, fmt.Errorf("cannot snapshot %d symbols", len(g.Symbols))
+	}
+	s := Snapshot{symbols: len(g.Symbols)}
+	for y := range g.Board {
+		s.width = len(g.Board[y])
+		for x := range g.Board[y] {
+			cell := g.Board[y][x].Cell
+			v := -1
+			if cell.Value != "" {
+				i, ok := cell.index().bit(cell.Value)
+				if !ok {
+					return Snapshot{}, fmt.Errorf("unknown symbol '%s' at %v", cell.Value, Loc{X: x, Y: y})
+				}
+				v = i
+			}
+			s.values = append(s.values, int8(v))
+			s.candidates = append(s.candidates, cell.candidates)
+		}
+	}
+	return s, nil
+}
+
+// Restore puts the values and candidates of a snapshot back on the board. It is recorded as one step in the history.
+func (g *Game) Restore(s Snapshot) error {
+	if len(g.Symbols) != s.symbols {
+		return fmt.Errorf("snapshot is for %d symbols, the game has %d", s.symbols, len(g.Symbols))
+	}
+	cells := 0
+	for y := range g.Board {
+		if len(g.Board[y]) != s.width {
+			return fmt.Errorf("snapshot is for a board with rows of %d cells", s.width)
+		}
+		cells += len(g.Board[y])
+	}
+	if cells != len(s.values) {
+		return fmt.Errorf("snapshot has %d cells, the board has %d", len(s.values), cells)
+	}
+
+	return g.Record("Restore", func() error {
+		id := 0
+		for y := range g.Board {
+			for x := range g.Board[y] {
+				cell := g.Board[y][x].Cell
+				before := cell.state()
+				cell.Value = ""
+				if v := s.values[id]; v >= 0 {
+					cell.Value = cell.index().symbol(int(v))
+				}
+				cell.candidates = s.candidates[id]
+				cell.RecentCandidates = nil
+				if before.Value != cell.Value || !slices.Equal(before.Candidates, cell.Candidates()) {
+					cell.record("restore", before)
+				}
+				id++
+			}
+		}
+		g.Solved = g.Won()
+		return nil
+	})
+}
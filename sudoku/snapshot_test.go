@@ -0,0 +1,64 @@
+package sudoku
+
+import (
+	"testing"
+
+	"github.com/mvndaai/sudoku_hints/sudoku/boards"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClone(t *testing.T) {
+	g := &Game{}
+	require.NoError(t, g.FillBasic(boards.NYTHard7July2025))
+	g.SetRating()
+	_, _, err := g.RemoveOneCandidate(false)
+	require.NoError(t, err)
+
+	c := g.Clone()
+	assert.Equal(t, cellStates(g), cellStates(c))
+	assert.Equal(t, g.Rating, c.Rating)
+	assert.Equal(t, g.Difficulty, c.Difficulty)
+	assert.Equal(t, g.History.Steps, c.History.Steps)
+
+	// Changing the copy leaves the game alone
+	before := cellStates(g)
+	c.Board[0][1].Cell.Set("1")
+	c.Board[0][3].Cell.RemoveCandiates(c.Board[0][3].Cell.Candidates()[:1])
+	c.Rating.Score = 100
+	assert.Equal(t, before, cellStates(g))
+	assert.NotEqual(t, 100.0, g.Rating.Score)
+	assert.Len(t, g.History.Steps, 1)
+	assert.Len(t, c.History.Steps, 3)
+
+	// Undoing the copy does not touch the game either
+	require.NoError(t, c.GoToStep(0))
+	assert.Equal(t, before, cellStates(g))
+}
+
+func TestSnapshot(t *testing.T) {
+	g := &Game{}
+	require.NoError(t, g.FillBasic(boards.NYTHard7July2025))
+	before := cellStates(g)
+
+	s, err := g.Snapshot()
+	require.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		_, _, err := g.RemoveOneCandidate(false)
+		require.NoError(t, err)
+	}
+	g.Board[0][1].Cell.Set("1")
+	require.NotEqual(t, before, cellStates(g))
+
+	require.NoError(t, g.Restore(s))
+	assert.Equal(t, before, cellStates(g))
+	assert.Equal(t, "Restore", g.History.Steps[len(g.History.Steps)-1].Source)
+
+	// Restoring is a step that can be undone
+	require.True(t, g.Undo())
+	assert.Equal(t, "1", g.Board[0][1].Cell.Value)
+
+	small := emptyGame(t)
+	small.Board = small.Board[:4]
+	assert.Error(t, small.Restore(s))
+}
@@ -0,0 +1,360 @@
+package sudoku
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+	"math/rand/v2"
+	"slices"
+)
+
+// ErrNoSolution is returned when the filled cells cannot be completed.
+var ErrNoSolution = errors.New("puzzle has no solution")
+
+// solver is a bitmask backtracking search over the cells of a game. Bit i of a mask stands for g.Symbols[i].
+type solver struct {
+	values     []int    // Symbol index of each cell, -1 when empty
+	cellHouses [][]int  // The rows, columns, groups, extra houses, cages and distinct lines each cell belongs to
+	used       []uint64 // Symbols placed in each house
+	cages      []solverCage
+	cellCages  [][]int // The cages each cell belongs to
+	lines      []solverLine
+	cellLines  [][]int  // The lines each cell belongs to
+	numbers    []int    // Number each symbol stands for in cage sums and lines
+	chess      [][]int  // The cells a knight's or king's move away that each cell cannot repeat
+	neighbors  [][]int  // The orthogonal neighbors of each cell in non-consecutive games
+	next       []uint64 // Symbols one away from each symbol in non-consecutive games
+	full       uint64
+	solution   []int
+	rand       *rand.Rand // Tries the candidates of a cell in a random order when set
+}
+
+type solverCage struct {
+	ids []int
+	sum int
+}
+
+type solverLine struct {
+	ids     []int
+	regions []int
+	rule    lineRule
+}
+
+func newSolver(g *Game) (*solver, error) {
+	if len(g.Symbols) == 0 || len(g.Symbols) > 64 {
+		return nil, fmt.Errorf("cannot solve with %d symbols", len(g.Symbols))
+	}
+	index := map[string]int{}
+	for i, symbol := range g.Symbols {
+		index[symbol] = i
+	}
+
+	s := &solver{full: uint64(1)<<len(g.Symbols) - 1}
+	ids := map[Loc]int{}
+	for y := range g.Board {
+		for x := range g.Board[y] {
+			ids[Loc{X: x, Y: y}] = len(s.values)
+			v := -1
+			if value := g.Board[y][x].Cell.Value; value != "" {
+				i, ok := index[value]
+				if !ok {
+					return nil, fmt.Errorf("unknown symbol '%s' at %v", value, Loc{X: x, Y: y})
+				}
+				v = i
+			}
+			s.values = append(s.values, v)
+		}
+	}
+
+	s.cellHouses = make([][]int, len(s.values))
+	rows, cols, groups := g.GetSectionedCells()
+	houses := slices.Concat(rows, cols, groups, g.ExtraHouseCells(), g.cageCells())
+	for i, cells := range g.lineCells() {
+		if g.Lines[i].distinct() {
+			houses = append(houses, cells)
+		}
+	}
+	for h, house := range houses {
+		for _, lc := range house {
+			id := ids[lc.Loc]
+			s.cellHouses[id] = append(s.cellHouses[id], h)
+		}
+		s.used = append(s.used, 0)
+	}
+
+	s.cellCages = make([][]int, len(s.values))
+	for i, c := range g.Cages {
+		cage := solverCage{sum: c.Sum}
+		for _, l := range c.Cells {
+			id, ok := ids[l]
+			if !ok {
+				return nil, fmt.Errorf("cage %d has %v outside of the board", i, l)
+			}
+			cage.ids = append(cage.ids, id)
+			s.cellCages[id] = append(s.cellCages[id], i)
+		}
+		s.cages = append(s.cages, cage)
+	}
+
+	s.cellLines = make([][]int, len(s.values))
+	for i, l := range g.Lines {
+		rule, ok := lineRules[l.Type]
+		if !ok {
+			return nil, fmt.Errorf("line %d has unknown type '%s'", i, l.Type)
+		}
+		line := solverLine{rule: rule}
+		for _, loc := range l.Cells {
+			id, ok := ids[loc]
+			if !ok {
+				return nil, fmt.Errorf("line %d has %v outside of the board", i, loc)
+			}
+			line.ids = append(line.ids, id)
+			line.regions = append(line.regions, g.groupOf(loc))
+			s.cellLines[id] = append(s.cellLines[id], i)
+		}
+		s.lines = append(s.lines, line)
+	}
+
+	s.chess = make([][]int, len(s.values))
+	s.neighbors = make([][]int, len(s.values))
+	for l, id := range ids {
+		for _, m := range slices.Concat(g.moves(l, knightMoves), g.moves(l, kingMoves)) {
+			if g.chessSees(l, m) && !slices.Contains(s.chess[id], ids[m]) {
+				s.chess[id] = append(s.chess[id], ids[m])
+			}
+		}
+		if g.NonConsecutive {
+			for _, m := range g.moves(l, orthogonal) {
+				s.neighbors[id] = append(s.neighbors[id], ids[m])
+			}
+		}
+	}
+
+	if len(s.cages) > 0 || len(s.lines) > 0 || g.NonConsecutive {
+		for _, symbol := range g.Symbols {
+			n, err := g.symbolNumber(symbol)
+			if err != nil {
+				return nil, err
+			}
+			s.numbers = append(s.numbers, n)
+		}
+	}
+
+	if g.NonConsecutive {
+		s.next = make([]uint64, len(s.numbers))
+		for i, n := range s.numbers {
+			for j, m := range s.numbers {
+				if m == n-1 || m == n+1 {
+					s.next[i] |= 1 << j
+				}
+			}
+		}
+	}
+
+	for id, v := range s.values {
+		if v < 0 {
+			continue
+		}
+		if s.candidates(id)&(1<<v) == 0 {
+			return nil, fmt.Errorf("%w: duplicate value '%s'", ErrNoSolution, g.Symbols[v])
+		}
+		s.place(id, v)
+	}
+	for i := range s.cages {
+		if !s.cageFits(i) {
+			return nil, fmt.Errorf("%w: cage %d cannot add up to %d", ErrNoSolution, i, s.cages[i].sum)
+		}
+	}
+	for i := range s.lines {
+		if !s.lineFits(i) {
+			return nil, fmt.Errorf("%w: %s line %d cannot be filled", ErrNoSolution, g.Lines[i].Type, i)
+		}
+	}
+	return s, nil
+}
+
+// fits reports whether the cages and lines of the cell can still be filled.
+func (s *solver) fits(id int) bool {
+	return !slices.ContainsFunc(s.cellCages[id], func(i int) bool { return !s.cageFits(i) }) &&
+		!slices.ContainsFunc(s.cellLines[id], func(i int) bool { return !s.lineFits(i) })
+}
+
+// lineFits reports whether the rule of the line leaves every cell of it something to hold.
+func (s *solver) lineFits(i int) bool {
+	l := s.lines[i]
+	options := make([][]int, len(l.ids))
+	for j, id := range l.ids {
+		if v := s.values[id]; v >= 0 {
+			options[j] = []int{s.numbers[v]}
+			continue
+		}
+		for mask := s.candidates(id); mask != 0; mask &= mask - 1 {
+			options[j] = append(options[j], s.numbers[bits.TrailingZeros64(mask)])
+		}
+		slices.Sort(options[j])
+	}
+	return !slices.ContainsFunc(l.rule(options, l.regions), func(o []int) bool { return len(o) == 0 })
+}
+
+// cageFits reports whether the empty cells of the cage can still make up the rest of its sum.
+func (s *solver) cageFits(i int) bool {
+	c := s.cages[i]
+	sum, empty := 0, 0
+	for _, id := range c.ids {
+		if v := s.values[id]; v >= 0 {
+			sum += s.numbers[v]
+		} else {
+			empty++
+		}
+	}
+	low, high := slices.Min(s.numbers), slices.Max(s.numbers)
+	return sum+empty*low <= c.sum && c.sum <= sum+empty*high
+}
+
+// candidates returns the symbols no house or chess move of the cell has placed yet, leaving out the symbols next to
+// the values of its neighbors in non-consecutive games.
+func (s *solver) candidates(id int) uint64 {
+	mask := s.full
+	for _, h := range s.cellHouses[id] {
+		mask &^= s.used[h]
+	}
+	for _, c := range s.chess[id] {
+		if v := s.values[c]; v >= 0 {
+			mask &^= 1 << v
+		}
+	}
+	for _, n := range s.neighbors[id] {
+		if v := s.values[n]; v >= 0 {
+			mask &^= s.next[v]
+		}
+	}
+	return mask
+}
+
+func (s *solver) place(id, v int) {
+	s.values[id] = v
+	for _, h := range s.cellHouses[id] {
+		s.used[h] |= 1 << v
+	}
+}
+
+func (s *solver) unplace(id, v int) {
+	s.values[id] = -1
+	for _, h := range s.cellHouses[id] {
+		s.used[h] &^= 1 << v
+	}
+}
+
+// search fills the empty cell with the fewest candidates first and counts solutions until it reaches the limit.
+// The first solution found is kept.
+func (s *solver) search(limit int) int {
+	best, bestMask, bestCount := -1, uint64(0), 65
+	for id, v := range s.values {
+		if v >= 0 {
+			continue
+		}
+		mask := s.candidates(id)
+		if n := bits.OnesCount64(mask); n < bestCount {
+			best, bestMask, bestCount = id, mask, n
+			if n == 0 {
+				return 0
+			}
+		}
+	}
+	if best < 0 {
+		if s.solution == nil {
+			s.solution = slices.Clone(s.values)
+		}
+		return 1
+	}
+
+	order := []int{}
+	for mask := bestMask; mask != 0; mask &= mask - 1 {
+		order = append(order, bits.TrailingZeros64(mask))
+	}
+	if s.rand != nil {
+		s.rand.Shuffle(len(order), func(i, j int) { order[i], order[j] = order[j], order[i] })
+	}
+
+	count := 0
+	for _, v := range order {
+		if count >= limit {
+			break
+		}
+		s.place(best, v)
+		if s.fits(best) {
+			count += s.search(limit - count)
+		}
+		s.unplace(best, v)
+	}
+	return count
+}
+
+// Solve returns the values of a solution that agrees with the filled cells, without changing the board.
+// Candidates are ignored so a wrong elimination cannot hide the solution.
+func (g *Game) Solve() ([][]string, error) {
+	s, err := newSolver(g)
+	if err != nil {
+		return nil, err
+	}
+	return g.solve(s)
+}
+
+// RandomSolution returns a solution picked at random by r, without changing the board.
+// Solving an empty board gives a random full grid.
+func (g *Game) RandomSolution(r *rand.Rand) ([][]string, error) {
+	s, err := newSolver(g)
+	if err != nil {
+		return nil, err
+	}
+	s.rand = r
+	return g.solve(s)
+}
+
+func (g *Game) solve(s *solver) ([][]string, error) {
+	if s.search(1) == 0 {
+		return nil, ErrNoSolution
+	}
+
+	grid := make([][]string, len(g.Board))
+	id := 0
+	for y := range g.Board {
+		grid[y] = make([]string, len(g.Board[y]))
+		for x := range g.Board[y] {
+			grid[y][x] = g.Symbols[s.solution[id]]
+			id++
+		}
+	}
+	return grid, nil
+}
+
+// CountSolutions returns how many solutions agree with the filled cells, stopping once it reaches the limit.
+// A limit below 1 counts every solution. A board that breaks the rules has none.
+func (g *Game) CountSolutions(limit int) int {
+	if limit < 1 {
+		limit = math.MaxInt
+	}
+	s, err := newSolver(g)
+	if err != nil {
+		return 0
+	}
+	return s.search(limit)
+}
+
+// HasUniqueSolution reports whether exactly one solution agrees with the filled cells.
+func (g *Game) HasUniqueSolution() bool {
+	return g.CountSolutions(2) == 1
+}
+
+// SolutionWarning explains why the puzzle does not have exactly one solution, or is empty when it does.
+func (g *Game) SolutionWarning() string {
+	switch g.CountSolutions(2) {
+	case 0:
+		return "puzzle has no solution, check the filled cells"
+	case 1:
+		return ""
+	default:
+		return "puzzle has more than one solution, hints may not lead to a single answer"
+	}
+}
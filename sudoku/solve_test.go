@@ -0,0 +1,119 @@
+package sudoku
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mvndaai/sudoku_hints/sudoku/boards"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// requireSolution checks the grid keeps the filled cells and breaks no rule of the game.
+func requireSolution(t *testing.T, g *Game, grid [][]string) {
+	t.Helper()
+	solved := g.clone()
+	for y := range solved.Board {
+		for x := range solved.Board[y] {
+			cell := solved.Board[y][x].Cell
+			if cell.Value != "" {
+				require.Equal(t, cell.Value, grid[y][x], "filled cell (x:%d,y:%d) changed", x, y)
+			}
+			cell.Set(grid[y][x])
+		}
+	}
+	require.True(t, solved.Won())
+	require.NoError(t, solved.BadBoard())
+}
+
+func TestSolve(t *testing.T) {
+	groups6x6 := map[Loc]int{}
+	for y := range 6 {
+		for x := range 6 {
+			groups6x6[Loc{X: x, Y: y}] = (y/2)*2 + x/3
+		}
+	}
+	jigsaw4x4 := map[Loc]int{}
+	for y, row := range []string{"0111", "0021", "3022", "3332"} {
+		for x, group := range row {
+			jigsaw4x4[Loc{X: x, Y: y}] = int(group - '0')
+		}
+	}
+
+	tests := []struct {
+		name    string
+		cells   [][]int
+		groups  map[Loc]int
+		symbols []string
+	}{
+		{name: "easy", cells: boards.BasicEasy, groups: DefaultGroup9x9},
+		{name: "hard", cells: boards.BasicHard, groups: DefaultGroup9x9},
+		{name: "extreme", cells: boards.SudokuDotComExtremeA, groups: DefaultGroup9x9},
+		{name: "empty", cells: boards.ZeroBoard, groups: DefaultGroup9x9},
+		{
+			name:    "6x6",
+			cells:   [][]int{{1, 0, 0, 0, 0, 6}, {0, 0, 0, 0, 0, 0}, {0, 0, 3, 4, 0, 0}, {0, 0, 0, 0, 0, 0}, {0, 0, 0, 0, 0, 0}, {6, 0, 0, 0, 0, 1}},
+			groups:  groups6x6,
+			symbols: []string{"1", "2", "3", "4", "5", "6"},
+		},
+		{
+			name:    "jigsaw 4x4",
+			cells:   [][]int{{1, 0, 0, 0}, {0, 0, 0, 0}, {0, 0, 0, 0}, {0, 0, 0, 0}},
+			groups:  jigsaw4x4,
+			symbols: []string{"1", "2", "3", "4"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			symbols := tt.symbols
+			if symbols == nil {
+				symbols = []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}
+			}
+			g := &Game{}
+			require.NoError(t, g.FillInts(tt.cells, tt.groups, symbols))
+			before, err := json.Marshal(g)
+			require.NoError(t, err)
+
+			grid, err := g.Solve()
+			require.NoError(t, err)
+			requireSolution(t, g, grid)
+
+			after, err := json.Marshal(g)
+			require.NoError(t, err)
+			assert.JSONEq(t, string(before), string(after), "the board should not change")
+		})
+	}
+}
+
+func TestSolveNoSolution(t *testing.T) {
+	g := &Game{}
+	require.NoError(t, g.FillInts([][]int{{1, 2, 0, 0}, {0, 0, 0, 0}, {0, 0, 3, 0}, {0, 0, 4, 0}}, DefaultGroup4x4, []string{"1", "2", "3", "4"}))
+
+	_, err := g.Solve()
+	assert.ErrorIs(t, err, ErrNoSolution)
+}
+
+func TestCountSolutions(t *testing.T) {
+	unique := &Game{}
+	require.NoError(t, unique.FillBasic(boards.BasicHard))
+	assert.Equal(t, 1, unique.CountSolutions(0))
+	assert.True(t, unique.HasUniqueSolution())
+	assert.Empty(t, unique.SolutionWarning())
+
+	empty := &Game{}
+	require.NoError(t, empty.FillBasic(boards.ZeroBoard))
+	assert.Equal(t, 5, empty.CountSolutions(5))
+	assert.False(t, empty.HasUniqueSolution())
+	assert.Equal(t, "puzzle has more than one solution, hints may not lead to a single answer", empty.SolutionWarning())
+
+	// Only the top left group is filled
+	swap := &Game{}
+	require.NoError(t, swap.FillInts([][]int{{1, 2, 0, 0}, {3, 4, 0, 0}, {0, 0, 0, 0}, {0, 0, 0, 0}}, DefaultGroup4x4, []string{"1", "2", "3", "4"}))
+	assert.Greater(t, swap.CountSolutions(0), 1)
+
+	none := &Game{}
+	require.NoError(t, none.FillInts([][]int{{1, 2, 0, 0}, {0, 0, 0, 0}, {0, 0, 3, 0}, {0, 0, 4, 0}}, DefaultGroup4x4, []string{"1", "2", "3", "4"}))
+	assert.Equal(t, 0, none.CountSolutions(0))
+	assert.Equal(t, "puzzle has no solution, check the filled cells", none.SolutionWarning())
+}
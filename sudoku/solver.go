@@ -71,6 +71,29 @@ type scanner interface {
 	Scan() bool
 }
 
+// stepHintLevels prints the next hint one level at a time, waiting for enter between them, then applies it.
+func (g *Game) stepHintLevels(w gameWriter, sc scanner) (change string, _ error) {
+	ok, h, err := g.NextHint()
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return "", ErrNoEliminations
+	}
+
+	for level := HintTechnique; level < HintApply; level++ {
+		fmt.Fprintln(w, color.New(color.FgCyan).Sprintf("Hint %d: %s", level, h.AtLevel(level).Message))
+		fmt.Fprint(w, color.New(color.FgYellow).Sprint("Enter for more help "))
+		sc.Scan()
+		fmt.Printf("\033[1A\033[K") // Move cursor up and clear the line
+	}
+
+	if err := g.ApplyHint(h); err != nil {
+		return "", err
+	}
+	return h.AtLevel(HintApply).Message, nil
+}
+
 func (g *Game) StepThrough(w gameWriter, sc scanner) {
 	//log.Println("Starting StepThrough...", g.CellsWithRecentCandidates())
 	var lastUpdated *Loc
@@ -89,7 +112,11 @@ func (g *Game) StepThrough(w gameWriter, sc scanner) {
 	for {
 		x, y, v, ok := g.SingleCadidate()
 		if !ok {
-			change, err := g.EliminateCandidates(false)
+			eliminate := g.EliminateCandidates
+			if g.HintLevels && !solve {
+				eliminate = func(bool) (string, error) { return g.stepHintLevels(w, sc) }
+			}
+			change, err := eliminate(false)
 			if err != nil {
 				w.Flush()
 				fmt.Fprintln(w, g.String(lastUpdated))
@@ -108,7 +135,10 @@ func (g *Game) StepThrough(w gameWriter, sc scanner) {
 		lastUpdated = &Loc{X: x, Y: y}
 		fmt.Fprint(w, f)
 		allChanges += f
-		g.Board[y][x].Cell.Set(v)
+		_ = g.Record("Single Candidate", func() error {
+			g.Board[y][x].Cell.Set(v)
+			return nil
+		})
 		g.SetLastFilled(x, y)
 
 		if err := g.BadBoard(); err != nil {
@@ -3,43 +3,42 @@ package sudoku
 import (
 	"fmt"
 	"log"
-	"slices"
 )
 
 func (g *Game) RemoveAllSimple(clearRecentCandidates bool) error {
 	//log.Println("in RemoveAllSimple")
 	eliminator := EliminatorFilledCell
-	rows, cols, groups := g.GetSectionedCells()
-	partitions := []struct {
-		name  string
-		cells [][]LocCell
-	}{
-		{"rows", rows},
-		{"cols", cols},
-		{"groups", groups},
-	}
-
-	for _, ps := range partitions {
-		for i := range ps.cells {
-			for {
-				ok, hint, err := eliminator.PartitionHinter(ps.cells[i])
-				if err != nil {
-					return fmt.Errorf("(%s) %s %d: %w", hint.Eliminator, ps.name, i, err)
-				}
-				if !ok {
-					//log.Println(cells, "no change")
-					break
-				}
+	err := g.Record(eliminator.Name, func() error {
+		for _, ps := range g.partitions() {
+			for i := range ps.cells {
+				for {
+					ok, hint, err := eliminator.PartitionHinter(ps.cells[i])
+					if err != nil {
+						return fmt.Errorf("(%s) %s %d: %w", hint.Eliminator, ps.name, i, err)
+					}
+					if !ok {
+						//log.Println(cells, "no change")
+						break
+					}
 
-				bfCandidates := slices.Clone(hint.cell.Candidates)
-				bfRecentCandidates := slices.Clone(hint.cell.RecentCandidates)
-				removed := hint.cell.RemoveCandiates(hint.CandidatesToRemove)
-				if len(removed) != 0 {
-					log.Println("Removed candidates:", hint.CandidatesToRemove, bfCandidates, bfRecentCandidates)
-					log.Println("After:", hint.cell.Candidates, hint.cell.RecentCandidates)
+					_ = hint.apply()
 				}
 			}
 		}
+		for {
+			ok, hint, err := eliminator.GameHinter(g)
+			if err != nil {
+				return fmt.Errorf("(%s): %w", eliminator.Name, err)
+			}
+			if !ok {
+				break
+			}
+			_ = hint.apply()
+		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
 	if clearRecentCandidates {
@@ -48,100 +47,86 @@ func (g *Game) RemoveAllSimple(clearRecentCandidates bool) error {
 	return nil
 }
 
-func (g *Game) RemoveOneCandidate(clearRecentCandidates bool) (bool, string, error) {
+// RemoveOneCandidate applies the first hint the eliminators find and returns it. It is false when none of them can
+// remove a candidate.
+func (g *Game) RemoveOneCandidate(clearRecentCandidates bool) (bool, Hint, error) {
 	if clearRecentCandidates {
 		g.RemoveAllRecentCandidates()
 	}
 
-	// First check if there are any candidates at all
-	candidateCount := 0
-	for _, row := range g.Board {
-		for _, gc := range row {
-			candidateCount += len(gc.Cell.Candidates)
-		}
+	ok, hint, err := g.NextHint()
+	if err != nil || !ok {
+		return false, Hint{}, err
+	}
+	if err := g.ApplyHint(hint); err != nil {
+		return false, Hint{}, err
 	}
-	//log.Printf("RemoveOneCandidate: Total candidates on board: %d\n", candidateCount)
+	log.Printf("RemoveOneCandidate: Successfully removed candidates using %s: %s\n", hint.Eliminator, hint)
+	return true, hint, nil
+}
 
-	//log.Println("RemoveOneCandidate: Starting search for candidates to remove")
+// NextHint returns the hint the next eliminator step would apply, without changing the board. It is false when none of
+// the eliminators can remove a candidate.
+func (g *Game) NextHint() (bool, Hint, error) {
+	return g.findHint(Eliminators)
+}
 
-	rows, cols, groups := g.GetSectionedCells()
+// ApplyHint removes the candidates of every target of the hint. The cells are found by their location so a hint
+// decoded from JSON can be applied. It fails when the hint no longer removes anything.
+func (g *Game) ApplyHint(h Hint) error {
+	targets := h.targets()
+	for _, t := range targets {
+		if !g.OnBoard(t.Loc) {
+			return fmt.Errorf("hint target %v is outside the board", t.Loc)
+		}
+	}
 
-	// Iterate through all eliminators
-	for _, eliminator := range Eliminators {
-		// Try PartitionEliminator if it exists
-		if eliminator.PartitionEliminator != nil {
-			partitions := []struct {
-				name  string
-				cells [][]LocCell
-			}{
-				{"rows", rows},
-				{"cols", cols},
-				{"groups", groups},
-			}
+	return g.Record(h.Eliminator, func() error {
+		removed := 0
+		for _, t := range targets {
+			removed += len(g.Board[t.Loc.Y][t.Loc.X].Cell.RemoveCandiates(t.Candidates))
+		}
+		if removed == 0 {
+			return fmt.Errorf("hint from %s does not remove any candidates", h.Eliminator)
+		}
+		return nil
+	})
+}
 
+// findHint returns the hint of the first enabled eliminator that can remove a candidate, along with the targets,
+// causes and houses of its pattern.
+func (g *Game) findHint(eliminators []CandidateEliminator) (bool, Hint, error) {
+	partitions := g.partitions()
+	for _, eliminator := range eliminators {
+		if !g.eliminatorEnabled(eliminator) {
+			continue
+		}
+		if eliminator.PartitionHinter != nil {
 			for _, ps := range partitions {
-				for i := range ps.cells {
-					// Track candidates before elimination
-					candidatesBeforeMap := make(map[Loc][]string)
-					for _, lc := range ps.cells[i] {
-						if len(lc.Cell.Candidates) > 0 {
-							candidatesBeforeMap[lc.Loc] = slices.Clone(lc.Cell.Candidates)
-						}
-					}
-
-					change, err := eliminator.PartitionEliminator(ps.cells[i])
+				for i, cells := range ps.cells {
+					ok, hint, err := eliminator.PartitionHinter(cells)
 					if err != nil {
-						return false, "", fmt.Errorf("(%s) %s %d: %w", eliminator.Name, ps.name, i, err)
+						return false, Hint{}, fmt.Errorf("(%s) %s %d: %w", eliminator.Name, ps.name, i, err)
 					}
-
-					if change != "" {
-						// Check if any candidates were actually removed
-						for _, lc := range ps.cells[i] {
-							before, existed := candidatesBeforeMap[lc.Loc]
-							if existed && len(before) != len(lc.Cell.Candidates) {
-								log.Printf("RemoveOneCandidate: Successfully removed candidates using %s: %s\n", eliminator.Name, change)
-								changeWithName := fmt.Sprintf("[%s] %s %d: %s", eliminator.Name, ps.name, i, change)
-								return true, changeWithName, nil
-							}
-						}
+					if ok {
+						hint.Eliminator = eliminator.Name
+						hint.Houses = addHouses(hint.Houses, House{Type: ps.house, Index: i})
+						return true, g.describeHint(hint), nil
 					}
 				}
 			}
 		}
 
-		// Try GameEliminator if it exists
-		if eliminator.GameEliminator != nil {
-			// Track total candidates before
-			totalBefore := 0
-			for _, row := range g.Board {
-				for _, gc := range row {
-					totalBefore += len(gc.Cell.Candidates)
-				}
-			}
-
-			change, err := eliminator.GameEliminator(g)
+		if eliminator.GameHinter != nil {
+			ok, hint, err := eliminator.GameHinter(g)
 			if err != nil {
-				return false, "", fmt.Errorf("(%s): %w", eliminator.Name, err)
+				return false, Hint{}, fmt.Errorf("(%s): %w", eliminator.Name, err)
 			}
-
-			if change != "" {
-				// Check if any candidates were removed
-				totalAfter := 0
-				for _, row := range g.Board {
-					for _, gc := range row {
-						totalAfter += len(gc.Cell.Candidates)
-					}
-				}
-
-				if totalAfter < totalBefore {
-					log.Printf("RemoveOneCandidate: Successfully removed candidates using %s: %s\n", eliminator.Name, change)
-					changeWithName := fmt.Sprintf("[%s] %s", eliminator.Name, change)
-					return true, changeWithName, nil
-				}
+			if ok {
+				hint.Eliminator = eliminator.Name
+				return true, g.describeHint(hint), nil
 			}
 		}
 	}
-
-	//log.Println("RemoveOneCandidate: No candidates found to remove across all eliminators")
-	return false, "", nil
+	return false, Hint{}, nil
 }
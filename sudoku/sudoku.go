@@ -1,7 +1,9 @@
 package sudoku
 
 import (
+	"encoding/json"
 	"fmt"
+	"maps"
 	"slices"
 	"strconv"
 )
@@ -21,15 +23,39 @@ type (
 		Loc                Loc      `json:"loc"`
 		CandidatesToRemove []string `json:"candidatesToRemove,omitempty"`
 		Eliminator         string   `json:"eliminator"`
+		Weight             float64  `json:"weight,omitempty"` // How hard this hint is to spot when it differs from the eliminator's weight
 		cell               *Cell    `json:"-"`
+
+		Targets []Target `json:"targets,omitempty"` // Every cell the hint removes candidates from, the first is Loc
+		Causes  []Cause  `json:"causes,omitempty"`  // Cells that make up the pattern
+		Houses  []House  `json:"houses,omitempty"`  // Houses the pattern looks at
+
+		Fish        *FishHint        `json:"fish,omitempty"`
+		Wing        *WingHint        `json:"wing,omitempty"`
+		SingleDigit *SingleDigitHint `json:"singleDigit,omitempty"`
+		Coloring    *ColoringHint    `json:"coloring,omitempty"`
+		Chain       *ChainHint       `json:"chain,omitempty"`
+		Uniqueness  *UniquenessHint  `json:"uniqueness,omitempty"`
+		ALS         *ALSHint         `json:"als,omitempty"`
+		SueDeCoq    *SueDeCoqHint    `json:"sueDeCoq,omitempty"`
+		Forcing     *ForcingHint     `json:"forcing,omitempty"`
+
+		CandidateChain *CandidateChainHint `json:"candidateChain,omitempty"`
+		Ring           *RingHint           `json:"ring,omitempty"`
+		Cage           *CageHint           `json:"cage,omitempty"`
+		Line           *LineHint           `json:"line,omitempty"`
 	}
 
 	Cell struct {
 		Value            string   `json:"value"`
-		Candidates       []string `json:"candidates"`
 		IsPreFilled      bool     `json:"isPreFilled"` // If true, this cell was part of the original puzzle and should not be changed
 		IsLastFilled     bool     `json:"isLastFilled"`
 		RecentCandidates []string `json:"recentCandidates"`
+
+		candidates CandidateSet
+		symbols    *symbolIndex // Shared by every cell of a game, cells made outside of a game use looseSymbols
+		loc        Loc
+		history    *History // Set when the game records changes, see Game.Record
 	}
 
 	Game struct {
@@ -37,6 +63,24 @@ type (
 		Board      [][]GroupedCell `json:"board"`
 		Solved     bool            `json:"solved"`
 		Difficulty string          `json:"difficulty,omitempty"`
+		Warning    string          `json:"warning,omitempty"` // Set when a loaded puzzle does not have exactly one solution
+		Rating     *Rating         `json:"rating,omitempty"`
+		Houses     []ExtraHouse    `json:"houses,omitempty"`   // Variant houses like diagonals or windows, set before filling the game
+		Variants   []string        `json:"variants,omitempty"` // Rulesets the puzzle uses, see Variants
+		Cages      []Cage          `json:"cages,omitempty"`    // Killer cages, set before filling the game
+		Lines      []Line          `json:"lines,omitempty"`    // Line constraints like thermometers, set before filling the game
+
+		AntiKnight     bool `json:"antiKnight,omitempty"`     // Cells a knight's move apart cannot hold the same value
+		AntiKing       bool `json:"antiKing,omitempty"`       // Cells a king's move apart cannot hold the same value
+		NonConsecutive bool `json:"nonConsecutive,omitempty"` // Orthogonal neighbors cannot hold values one apart
+
+		SymbolValues map[string]int `json:"symbolValues,omitempty"` // Numbers the symbols stand for in sums and lines, unset reads the symbols as numbers
+
+		MaxChainLength int  `json:"maxChainLength,omitempty"` // Most links the chain eliminators follow, 0 uses DefaultMaxChainLength
+		AssumeUnique   bool `json:"assumeUnique,omitempty"`   // Allows uniqueness eliminators, only valid when the puzzle has a single solution. Games with extra rules never use them
+		AllowForcing   bool `json:"allowForcing,omitempty"`   // Allows the forcing eliminators that try candidates when the patterns are stuck
+
+		History *History `json:"-"` // Changes made since the puzzle was filled
 
 		// Used only in bash
 		HideSimple        bool
@@ -44,6 +88,7 @@ type (
 		RunSimpleFirst    bool // If true, the simple eliminators will be run quietly first
 		RunOnce           bool // If true, breaks after finding one value
 		RunSimpleAfter    bool // If true, runs simple eliminators after other eliminators
+		HintLevels        bool // If true, each elimination is shown one hint level at a time before it is applied
 		AutoSolve         bool
 	}
 )
@@ -88,20 +133,44 @@ func (g *Game) Fill(cells [][]string, group map[Loc]int, symbols []string) error
 		return fmt.Errorf("number of symbols (%d) does not match number of group values (%d)", len(g.Symbols), len(groupVals))
 	}
 	slices.Sort(g.Symbols)
+	index, err := newSymbolIndex(g.Symbols)
+	if err != nil {
+		return err
+	}
+	if err := g.applyVariants(); err != nil {
+		return err
+	}
+	if err := g.checkHouses(); err != nil {
+		return err
+	}
+	if err := g.checkCages(); err != nil {
+		return err
+	}
+	if err := g.checkLines(); err != nil {
+		return err
+	}
+	if g.NonConsecutive {
+		if err := g.checkSymbolNumbers(); err != nil {
+			return fmt.Errorf("non-consecutive needs numbers: %w", err)
+		}
+	}
 
 	// Initialize options for empty cells
 	for y := range g.Board {
 		for x := range g.Board[y] {
-			if g.Board[y][x].Cell.Value == "" {
-				g.Board[y][x].Cell.Candidates = slices.Clone(g.Symbols)
+			cell := g.Board[y][x].Cell
+			cell.symbols = index
+			if cell.Value == "" {
+				cell.candidates = index.all()
 			}
 		}
 	}
 
-	err := g.RemoveAllSimple(true)
+	err = g.RemoveAllSimple(true)
 	if err != nil {
 		return fmt.Errorf("failed to remove all simple candidates: %w", err)
 	}
+	g.trackHistory()
 	return nil
 }
 
@@ -148,42 +217,190 @@ func (g *Game) FillInts(cells [][]int, group map[Loc]int, symbols []string) erro
 	return g.Fill(strCells, group, symbols)
 }
 
+// clone copies the board and the solving options so candidates can be tried without changing the game.
+func (g *Game) clone() *Game {
+	c := &Game{
+		Symbols:        g.Symbols,
+		Board:          make([][]GroupedCell, len(g.Board)),
+		Houses:         g.Houses,
+		Variants:       g.Variants,
+		Cages:          g.Cages,
+		Lines:          g.Lines,
+		SymbolValues:   g.SymbolValues,
+		AntiKnight:     g.AntiKnight,
+		AntiKing:       g.AntiKing,
+		NonConsecutive: g.NonConsecutive,
+		MaxChainLength: g.MaxChainLength,
+		AssumeUnique:   g.AssumeUnique,
+		AllowForcing:   g.AllowForcing,
+	}
+	for y := range g.Board {
+		c.Board[y] = make([]GroupedCell, len(g.Board[y]))
+		for x, gc := range g.Board[y] {
+			cell := *gc.Cell
+			cell.RecentCandidates = nil
+			cell.history = nil
+			c.Board[y][x] = GroupedCell{group: gc.group, Cell: &cell}
+		}
+	}
+	return c
+}
+
+// Clone returns a deep copy of the game that shares no cells with it, so it can be changed without changing the game.
+// The copy keeps its own history of the steps made so far.
+func (g *Game) Clone() *Game {
+	c := g.clone()
+	board := c.Board
+	*c = *g
+	c.Board = board
+	c.Symbols = slices.Clone(g.Symbols)
+	c.Variants = slices.Clone(g.Variants)
+	c.Houses = slices.Clone(g.Houses)
+	for i := range c.Houses {
+		c.Houses[i].Cells = slices.Clone(g.Houses[i].Cells)
+	}
+	c.Cages = slices.Clone(g.Cages)
+	for i := range c.Cages {
+		c.Cages[i].Cells = slices.Clone(g.Cages[i].Cells)
+	}
+	c.Lines = slices.Clone(g.Lines)
+	for i := range c.Lines {
+		c.Lines[i].Cells = slices.Clone(g.Lines[i].Cells)
+	}
+	c.SymbolValues = maps.Clone(g.SymbolValues)
+	for y := range g.Board {
+		for x, gc := range g.Board[y] {
+			c.Board[y][x].Cell.RecentCandidates = slices.Clone(gc.Cell.RecentCandidates)
+		}
+	}
+	if g.Rating != nil {
+		r := *g.Rating
+		c.Rating = &r
+	}
+
+	c.History = nil
+	if g.History != nil {
+		c.trackHistory()
+		c.History.Steps = slices.Clip(slices.Clone(g.History.Steps))
+		c.History.Position = g.History.Position
+	}
+	return c
+}
+
 func (c *Cell) Set(v string) {
+	before := c.state()
 	c.Value = v
-	c.Candidates = nil // Clear options since the cell is now filled
+	c.candidates = 0 // Clear options since the cell is now filled
+	c.record("set", before)
+}
+
+// cellJSON is the JSON view of a cell, which lists the candidates as symbols.
+type cellJSON struct {
+	Value            string   `json:"value"`
+	Candidates       []string `json:"candidates"`
+	IsPreFilled      bool     `json:"isPreFilled"`
+	IsLastFilled     bool     `json:"isLastFilled"`
+	RecentCandidates []string `json:"recentCandidates"`
+}
+
+func (c Cell) MarshalJSON() ([]byte, error) {
+	return json.Marshal(cellJSON{
+		Value:            c.Value,
+		Candidates:       c.Candidates(),
+		IsPreFilled:      c.IsPreFilled,
+		IsLastFilled:     c.IsLastFilled,
+		RecentCandidates: c.RecentCandidates,
+	})
+}
+
+// UnmarshalJSON reads a cell that is not part of a game, so its candidates use the loose symbols.
+func (c *Cell) UnmarshalJSON(b []byte) error {
+	var v cellJSON
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	c.Value = v.Value
+	c.IsPreFilled = v.IsPreFilled
+	c.IsLastFilled = v.IsLastFilled
+	c.RecentCandidates = v.RecentCandidates
+	c.SetCandidates(v.Candidates...)
+	return nil
+}
+
+// index returns the symbols the candidates of the cell are stored by.
+func (c *Cell) index() *symbolIndex {
+	if c.symbols == nil {
+		return looseSymbols
+	}
+	return c.symbols
+}
+
+// Candidates returns the candidates of the cell in the order of the game symbols.
+func (c *Cell) Candidates() []string {
+	return c.index().strings(c.candidates)
+}
+
+// CandidateSet returns the candidates of the cell as bits of the game symbols.
+func (c *Cell) CandidateSet() CandidateSet {
+	return c.candidates
+}
+
+func (c *Cell) CandidateCount() int {
+	return c.candidates.Count()
+}
+
+// SetCandidates replaces the candidates of the cell. Symbols that are not part of the game are dropped.
+func (c *Cell) SetCandidates(vs ...string) {
+	c.candidates = c.index().set(vs)
+}
+
+// valueSet returns the value of the cell as a set, empty when the cell is not filled.
+func (c *Cell) valueSet() CandidateSet {
+	if c.Value == "" {
+		return 0
+	}
+	i, ok := c.index().bit(c.Value)
+	if !ok {
+		return 0
+	}
+	return CandidateSet(0).With(i)
+}
+
+// onlyCandidate returns the candidate of a cell that has exactly one.
+func (c *Cell) onlyCandidate() (string, bool) {
+	if c.candidates.Count() != 1 {
+		return "", false
+	}
+	return c.index().symbol(c.candidates.Indexes()[0]), true
 }
 
 func (c *Cell) RemoveCandiates(vs []string) (removed []string) {
-	//log.Println("in remove Candidates", vs)
 	if c.Value != "" {
 		return nil // Cell is already filled, nothing to remove
 	}
+	return c.removeSet(c.index().set(vs))
+}
 
-	// Remove empty vs values
-	vs = slices.DeleteFunc(vs, func(v string) bool {
-		return v == ""
-	})
-
-	removed = []string{}
-	//log.Println(c.Candidates)
-	c.Candidates = slices.DeleteFunc(c.Candidates, func(c string) bool {
-		if slices.Contains(vs, c) {
-			removed = append(removed, c)
-			//log.Println("hello")
-			return true
-		}
-		return false
-	})
-	//log.Println(c.Candidates)
-
-	if len(removed) != 0 {
-		//log.Println("RemoveCandidates: removed candidates:", removed, "from cell, adding to RecentCandidates")
-		c.RecentCandidates = append(c.RecentCandidates, removed...)
-		//log.Println("RecentCandidates is now:", c.RecentCandidates)
+// removeSet removes the candidates in s and returns the ones the cell had.
+func (c *Cell) removeSet(s CandidateSet) (removed []string) {
+	s &= c.candidates
+	if s == 0 {
+		return []string{}
 	}
+
+	before := c.state()
+	c.candidates &^= s
+	removed = c.index().strings(s)
+	c.RecentCandidates = append(c.RecentCandidates, removed...)
+	c.record("removeCandidates", before)
 	return removed
 }
 
+func (c *Cell) HasCandidate(v string) bool {
+	i, ok := c.index().bit(v)
+	return ok && c.candidates.Has(i)
+}
+
 func (g *Game) RemoveAllRecentCandidates() {
 	for y := range g.Board {
 		for x := range g.Board[y] {
@@ -207,34 +424,25 @@ func (g *Game) CellsWithRecentCandidates() int {
 	return count
 }
 
-func (c *Cell) CandidateDiffs(vs []string) (removed []string) {
+// CandidateDiffs returns the candidates of the cell that are in vs, without removing them.
+func (c *Cell) CandidateDiffs(vs []string) (diffs []string) {
 	if c.Value != "" {
 		return nil // Cell is already filled, nothing to remove
 	}
 
-	// Remove empty vs values
-	vs = slices.DeleteFunc(vs, func(v string) bool {
-		return v == ""
-	})
-
-	removed = []string{}
-	c.Candidates = slices.DeleteFunc(c.Candidates, func(c string) bool {
-		if slices.Contains(vs, c) {
-			removed = append(removed, c)
-			return true
-		}
-		return false
-	})
-
-	return removed
+	diffs = c.index().strings(c.candidates & c.index().set(vs))
+	if diffs == nil {
+		diffs = []string{}
+	}
+	return diffs
 }
 
 func (g *Game) SingleCadidate() (x, y int, v string, ok bool) {
 	for y := range g.Board {
 		for x := range g.Board[y] {
 			cell := g.Board[y][x].Cell
-			if len(cell.Candidates) == 1 {
-				return x, y, cell.Candidates[0], true
+			if v, ok := cell.onlyCandidate(); ok {
+				return x, y, v, true
 			}
 		}
 	}
@@ -259,8 +467,12 @@ func (g *Game) SetValue(row, col int, value string) error {
 		for jx := range g.Board[iy] {
 			cell := g.Board[iy][jx].Cell
 			if iy == row && jx == col {
+				before := cell.state()
 				cell.IsLastFilled = true
 				cell.Value = value
+				if before.Value != value {
+					cell.record("setValue", before)
+				}
 				continue
 			}
 			cell.IsLastFilled = false
@@ -284,50 +496,49 @@ func (g *Game) Won() bool {
 }
 
 func (g *Game) BadBoard() error {
-	rows, cols, groups := g.GetSectionedCells()
-	// Check all sections (rows, columns, groups)
-	sections := []struct {
-		name  string
-		cells [][]LocCell
-	}{
-		{"row", rows},
-		{"column", cols},
-		{"group", groups},
-	}
-
-	for _, section := range sections {
+	// Check all sections (rows, columns, groups, extra houses)
+	for _, section := range g.partitions() {
 		for i, cells := range section.cells {
 			values := make(map[string][]Loc)
 			singleCandidates := make(map[string][]Loc)
 
 			for _, lc := range cells {
-				//log.Println("Checking cell:", lc.Loc, lc.Cell.Value, len(lc.Cell.Candidates), lc.Cell.Candidates)
-				if lc.Cell.Value == "" && len(lc.Cell.Candidates) == 0 {
+				if lc.Cell.Value == "" && lc.Cell.CandidateCount() == 0 {
 					return fmt.Errorf("empty cell with no candidates: %v", lc.Loc)
 				}
 
 				if lc.Cell.Value != "" {
 					values[lc.Cell.Value] = append(values[lc.Cell.Value], lc.Loc)
-				} else if len(lc.Cell.Candidates) == 1 {
-					singleCandidates[lc.Cell.Candidates[0]] = append(singleCandidates[lc.Cell.Candidates[0]], lc.Loc)
+				} else if v, ok := lc.Cell.onlyCandidate(); ok {
+					singleCandidates[v] = append(singleCandidates[v], lc.Loc)
 				}
 			}
 
 			// Check for duplicate values
 			for v, locs := range values {
 				if len(locs) > 1 {
-					return fmt.Errorf("duplicate value '%s' in %s %d at positions %v", v, section.name, i, locs)
+					return fmt.Errorf("duplicate value '%s' in %s at positions %v", v, g.houseName(section.house, i), locs)
 				}
 			}
 
 			// Check for duplicate single candidates
 			for v, locs := range singleCandidates {
 				if len(locs) > 1 {
-					return fmt.Errorf("multiple cells with only candidate '%s' in %s %d at positions %v", v, section.name, i, locs)
+					return fmt.Errorf("multiple cells with only candidate '%s' in %s at positions %v", v, g.houseName(section.house, i), locs)
 				}
 			}
 		}
 	}
 
+	if err := g.checkCageSums(); err != nil {
+		return err
+	}
+	if err := g.checkLineRules(); err != nil {
+		return err
+	}
+	if err := g.checkExtraPeers(); err != nil {
+		return err
+	}
+
 	return nil // Board is valid
 }
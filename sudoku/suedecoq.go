@@ -0,0 +1,141 @@
+package sudoku
+
+import (
+	"fmt"
+	"slices"
+)
+
+// SueDeCoqHint describes the cells where a group and line cross, and the cells in the rest of each that share their candidates.
+type SueDeCoqHint struct {
+	House        string `json:"house"` // "row" or "column"
+	Index        int    `json:"index"`
+	Group        int    `json:"group"`
+	Intersection []Loc  `json:"intersection"`
+	LineCells    []Loc  `json:"lineCells"`
+	GroupCells   []Loc  `json:"groupCells"`
+}
+
+func (s SueDeCoqHint) String() string {
+	return fmt.Sprintf("with Sue de Coq on %s %d and group %d using %s with %s and %s",
+		s.House, s.Index, s.Group, formatLocs(s.Intersection), formatLocs(s.LineCells), formatLocs(s.GroupCells))
+}
+
+func (s SueDeCoqHint) explain(g *Game) ([]Cause, []House) {
+	return g.causes(slices.Concat(s.Intersection, s.LineCells, s.GroupCells)),
+		[]House{{Type: s.House, Index: s.Index}, {Type: "group", Index: s.Group}}
+}
+
+var EliminatorSueDeCoq = func() CandidateEliminator {
+	name := "Sue de Coq"
+	r := CandidateEliminator{
+		Name:        name,
+		Description: "Two or three cells where a group and a line cross hold at least two more candidates than cells. Cells in the rest of the line and the rest of the group that share no candidates with each other lock the candidates, so they can be removed elsewhere in the line and group.",
+		Weight:      5.0,
+		GameHinter: func(g *Game) (bool, Hint, error) {
+			for _, in := range g.Intersections() {
+				if ok, h := in.sueDeCoq(); ok {
+					h.Eliminator = name
+					return true, h, nil
+				}
+			}
+			return false, Hint{}, nil
+		},
+	}
+
+	r.GameEliminator = GameHinterToEliminator(r.GameHinter)
+	return r
+}()
+
+// unsolvedCells returns the cells that still have candidates.
+func unsolvedCells(cells []LocCell) []LocCell {
+	return slices.DeleteFunc(slices.Clone(cells), func(lc LocCell) bool { return lc.Cell.Value != "" })
+}
+
+func (in Intersection) sueDeCoq() (bool, Hint) {
+	lineRest := unsolvedCells(in.LineRest)
+	groupRest := unsolvedCells(in.GroupRest)
+	for size := 2; size <= 3; size++ {
+		for _, cells := range getCombinations(unsolvedCells(in.Cells), size) {
+			candidates := unionCandidates(cells)
+			if len(candidates) < size+2 {
+				continue
+			}
+			for lineSize := 1; lineSize <= 2; lineSize++ {
+				for _, lineCells := range getCombinations(lineRest, lineSize) {
+					for groupSize := 1; groupSize <= 2; groupSize++ {
+						for _, groupCells := range getCombinations(groupRest, groupSize) {
+							if ok, h := in.sueDeCoqHint(cells, lineCells, groupCells); ok {
+								return true, h
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return false, Hint{}
+}
+
+// sueDeCoqHint checks that the cells hold exactly as many candidates as cells. The line and group cells must not share
+// candidates, so every candidate is placed once in them and can be removed from the rest of the line or group it is locked to.
+func (in Intersection) sueDeCoqHint(cells, lineCells, groupCells []LocCell) (bool, Hint) {
+	lineCandidates := unionCandidates(lineCells)
+	groupCandidates := unionCandidates(groupCells)
+	if slices.ContainsFunc(lineCandidates, func(c string) bool { return slices.Contains(groupCandidates, c) }) {
+		return false, Hint{}
+	}
+	all := slices.Concat(cells, lineCells, groupCells)
+	if len(unionCandidates(all)) != len(all) {
+		return false, Hint{}
+	}
+
+	// Line candidates are in the line cells or the intersection, and the same for the group.
+	// Candidates only in the intersection are locked to both.
+	lineLocked := slices.DeleteFunc(unionCandidates(slices.Concat(cells, lineCells)), func(c string) bool { return slices.Contains(groupCandidates, c) })
+	groupLocked := slices.DeleteFunc(unionCandidates(slices.Concat(cells, groupCells)), func(c string) bool { return slices.Contains(lineCandidates, c) })
+
+	used := []Loc{}
+	for _, lc := range all {
+		used = append(used, lc.Loc)
+	}
+	locs := func(cells []LocCell) []Loc {
+		l := []Loc{}
+		for _, lc := range cells {
+			l = append(l, lc.Loc)
+		}
+		return l
+	}
+
+	for _, rest := range []struct {
+		cells  []LocCell
+		locked []string
+	}{
+		{in.LineRest, lineLocked},
+		{in.GroupRest, groupLocked},
+		{in.Cells, unionCandidates(slices.Concat(cells, lineCells, groupCells))},
+	} {
+		for _, lc := range rest.cells {
+			if slices.Contains(used, lc.Loc) {
+				continue
+			}
+			remove := lc.Cell.candidatesIn(rest.locked)
+			if len(remove) == 0 {
+				continue
+			}
+			return true, Hint{
+				Loc:                lc.Loc,
+				CandidatesToRemove: remove,
+				cell:               lc.Cell,
+				SueDeCoq: &SueDeCoqHint{
+					House:        in.House,
+					Index:        in.Index,
+					Group:        in.Group,
+					Intersection: locs(cells),
+					LineCells:    locs(lineCells),
+					GroupCells:   locs(groupCells),
+				},
+			}
+		}
+	}
+	return false, Hint{}
+}
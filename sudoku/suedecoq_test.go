@@ -0,0 +1,47 @@
+package sudoku
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntersections(t *testing.T) {
+	g := emptyGame(t)
+	intersections := g.Intersections()
+	assert.Len(t, intersections, 54)
+
+	first := intersections[0]
+	assert.Equal(t, "row", first.House)
+	assert.Equal(t, 0, first.Index)
+	assert.Equal(t, 0, first.Group)
+	assert.Len(t, first.Cells, 3)
+	assert.Len(t, first.LineRest, 6)
+	assert.Len(t, first.GroupRest, 6)
+}
+
+func TestEliminatorSueDeCoq(t *testing.T) {
+	g := emptyGame(t)
+	setCandidates(g, Loc{X: 0, Y: 0}, "1", "2", "3")
+	setCandidates(g, Loc{X: 1, Y: 0}, "2", "3", "4")
+	setCandidates(g, Loc{X: 5, Y: 0}, "1", "2")
+	setCandidates(g, Loc{X: 0, Y: 1}, "3", "4")
+
+	detail := "with Sue de Coq on row 0 and group 0 using (x:0,y:0) (x:1,y:0) with (x:5,y:0) and (x:0,y:1)"
+	changes := collectChanges(t, g, EliminatorSueDeCoq)
+	assert.Equal(t, []string{
+		"removed candidates (x:3,y:0) [1 2] " + detail,
+		"removed candidates (x:4,y:0) [1 2] " + detail,
+		"removed candidates (x:6,y:0) [1 2] " + detail,
+		"removed candidates (x:7,y:0) [1 2] " + detail,
+		"removed candidates (x:8,y:0) [1 2] " + detail,
+		"removed candidates (x:1,y:1) [3 4] " + detail,
+		"removed candidates (x:2,y:1) [3 4] " + detail,
+		"removed candidates (x:0,y:2) [3 4] " + detail,
+		"removed candidates (x:1,y:2) [3 4] " + detail,
+		"removed candidates (x:2,y:2) [3 4] " + detail,
+		"removed candidates (x:2,y:0) [1 2 3 4] " + detail,
+	}, changes)
+	require.NoError(t, g.BadBoard())
+}
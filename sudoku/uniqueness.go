@@ -0,0 +1,373 @@
+package sudoku
+
+import (
+	"fmt"
+	"slices"
+)
+
+// UniquenessHint describes a pattern that would give the puzzle two solutions if a candidate were left in place.
+type UniquenessHint struct {
+	Name    string   `json:"name"`
+	Symbols []string `json:"symbols"` // The pair in a rectangle or the true symbol of a BUG+1
+	Cells   []Loc    `json:"cells"`
+}
+
+func (u UniquenessHint) String() string {
+	return fmt.Sprintf("with %s on %v at %s", u.Name, u.Symbols, formatLocs(u.Cells))
+}
+
+func (u UniquenessHint) explain(g *Game) ([]Cause, []House) {
+	return g.causes(u.Cells), nil
+}
+
+// rectangle is four unsolved cells on two rows, two columns and two groups.
+// The cells are ordered top left, top right, bottom left, bottom right so the opposite corner of i is 3-i.
+type rectangle [4]LocCell
+
+func (r rectangle) locs() []Loc {
+	locs := make([]Loc, 0, len(r))
+	for _, lc := range r {
+		locs = append(locs, lc.Loc)
+	}
+	return locs
+}
+
+// rectangles returns every rectangle that could become a deadly pattern.
+func (g *Game) rectangles() []rectangle {
+	rects := []rectangle{}
+	for y1 := range g.Board {
+		for y2 := y1 + 1; y2 < len(g.Board); y2++ {
+			for x1 := range g.Board[y1] {
+				for x2 := x1 + 1; x2 < len(g.Board[y1]); x2++ {
+					r := rectangle{}
+					groups := map[int]struct{}{}
+					unsolved := true
+					for i, l := range []Loc{{X: x1, Y: y1}, {X: x2, Y: y1}, {X: x1, Y: y2}, {X: x2, Y: y2}} {
+						r[i] = LocCell{Loc: l, Cell: g.Board[l.Y][l.X].Cell}
+						groups[g.groupOf(l)] = struct{}{}
+						unsolved = unsolved && r[i].Cell.Value == ""
+					}
+					if unsolved && len(groups) == 2 {
+						rects = append(rects, r)
+					}
+				}
+			}
+		}
+	}
+	return rects
+}
+
+// pairs returns each pair of candidates shared by all four cells.
+func (r rectangle) pairs() [][]string {
+	shared := r[0].Cell.Candidates()
+	for _, lc := range r[1:] {
+		shared = slices.DeleteFunc(shared, func(c string) bool { return !lc.Cell.HasCandidate(c) })
+	}
+	return getCombinations(shared, 2)
+}
+
+// sharedHouses returns the rows, columns and groups holding both cells.
+func (g *Game) sharedHouses(a, b Loc) [][]LocCell {
+	rows, cols, groups := g.GetSectionedCells()
+	houses := [][]LocCell{}
+	if a.Y == b.Y {
+		houses = append(houses, rows[a.Y])
+	}
+	if a.X == b.X {
+		houses = append(houses, cols[a.X])
+	}
+	if g.groupOf(a) == g.groupOf(b) {
+		houses = append(houses, groups[g.groupOf(a)])
+	}
+	return houses
+}
+
+// candidateLocs returns the cells in the house that have the candidate.
+func candidateLocs(cells []LocCell, symbol string) []Loc {
+	locs := []Loc{}
+	for _, lc := range cells {
+		if lc.Cell.HasCandidate(symbol) {
+			locs = append(locs, lc.Loc)
+		}
+	}
+	return locs
+}
+
+var EliminatorUniqueRectangle = func() CandidateEliminator {
+	name := "Unique Rectangle"
+	r := CandidateEliminator{
+		Name:        name,
+		Description: "Four cells on two rows, two columns and two groups cannot all end up with the same two candidates or the puzzle would have two solutions. The extra candidates in the rectangle are used to break the pattern.",
+		Weight:      4.5,
+		Uniqueness:  true,
+		GameHinter: func(g *Game) (bool, Hint, error) {
+			for _, rect := range g.rectangles() {
+				for _, pair := range rect.pairs() {
+					if ok, h := g.uniqueRectangle(rect, pair); ok {
+						h.Eliminator = name
+						return true, h, nil
+					}
+				}
+			}
+			return false, Hint{}, nil
+		},
+	}
+
+	r.GameEliminator = GameHinterToEliminator(r.GameHinter)
+	return r
+}()
+
+// uniqueRectangle checks types 1 to 6 on a rectangle where every cell has the pair.
+func (g *Game) uniqueRectangle(rect rectangle, pair []string) (bool, Hint) {
+	roof := []int{} // Corners with candidates beyond the pair
+	for i, lc := range rect {
+		if lc.Cell.CandidateCount() > 2 {
+			roof = append(roof, i)
+		}
+	}
+
+	hint := func(typ int, target LocCell, remove ...string) (bool, Hint) {
+		return true, Hint{
+			Loc:                target.Loc,
+			CandidatesToRemove: remove,
+			cell:               target.Cell,
+			Uniqueness: &UniquenessHint{
+				Name:    fmt.Sprintf("Unique Rectangle Type %d", typ),
+				Symbols: pair,
+				Cells:   rect.locs(),
+			},
+		}
+	}
+
+	// Type 1: only one cell has extra candidates so it cannot be either of the pair
+	if len(roof) == 1 {
+		return hint(1, rect[roof[0]], pair...)
+	}
+
+	extras := []LocCell{}
+	for _, i := range roof {
+		extra := slices.DeleteFunc(rect[i].Cell.Candidates(), func(c string) bool { return slices.Contains(pair, c) })
+		extras = append(extras, LocCell{Loc: rect[i].Loc, Cell: rect[i].Cell.withCandidates(extra)})
+	}
+	roofLocs := []Loc{}
+	for _, i := range roof {
+		roofLocs = append(roofLocs, rect[i].Loc)
+	}
+
+	// Types 2 and 5: every cell with extras has the same single extra, so one of them must be it
+	if union := unionCandidates(extras); len(roof) <= 3 && len(union) == 1 && !slices.ContainsFunc(extras, func(lc LocCell) bool { return lc.Cell.CandidateCount() != 1 }) {
+		typ := 5
+		if len(roof) == 2 && (roofLocs[0].X == roofLocs[1].X || roofLocs[0].Y == roofLocs[1].Y) {
+			typ = 2
+		}
+		for _, lc := range g.CommonPeers(roofLocs) {
+			if lc.Cell.HasCandidate(union[0]) && !slices.Contains(rect.locs(), lc.Loc) {
+				return hint(typ, lc, union[0])
+			}
+		}
+	}
+	if len(roof) != 2 {
+		return false, Hint{}
+	}
+	a, b := rect[roof[0]], rect[roof[1]]
+
+	// Type 4: one of the pair only fits in the two cells with extras, so the other of the pair cannot be there
+	for _, house := range g.sharedHouses(a.Loc, b.Loc) {
+		for i, symbol := range pair {
+			other := pair[1-i]
+			if len(candidateLocs(house, symbol)) != 2 {
+				continue
+			}
+			for _, lc := range []LocCell{a, b} {
+				if lc.Cell.HasCandidate(other) {
+					return hint(4, lc, other)
+				}
+			}
+		}
+	}
+
+	// Type 6: the floor is diagonal and one of the pair forms an X-Wing on the rectangle, so the roof cannot have it
+	if roof[0]+roof[1] == 3 {
+		rows, cols, _ := g.GetSectionedCells()
+		for _, symbol := range pair {
+			xWing := true
+			for _, line := range [][]LocCell{rows[rect[0].Loc.Y], rows[rect[3].Loc.Y], cols[rect[0].Loc.X], cols[rect[3].Loc.X]} {
+				for _, l := range candidateLocs(line, symbol) {
+					xWing = xWing && slices.Contains(rect.locs(), l)
+				}
+			}
+			if !xWing {
+				continue
+			}
+			for _, lc := range []LocCell{a, b} {
+				if lc.Cell.HasCandidate(symbol) {
+					return hint(6, lc, symbol)
+				}
+			}
+		}
+	}
+
+	// Type 3: the extras act as one cell that forms a naked subset with other cells in a shared house
+	roofExtras := unionCandidates(extras)
+	for _, house := range g.sharedHouses(a.Loc, b.Loc) {
+		others := []LocCell{}
+		for _, lc := range house {
+			if lc.Cell.Value == "" && !slices.Contains(rect.locs(), lc.Loc) {
+				others = append(others, lc)
+			}
+		}
+		for size := 1; size <= 3 && size < len(others); size++ {
+			for _, subset := range getCombinations(others, size) {
+				union := unionCandidates(append(subset, LocCell{Cell: a.Cell.withCandidates(roofExtras)}))
+				if len(union) != size+1 {
+					continue
+				}
+				for _, lc := range others {
+					if slices.ContainsFunc(subset, func(s LocCell) bool { return s.Loc == lc.Loc }) {
+						continue
+					}
+					if remove := lc.Cell.candidatesIn(union); len(remove) > 0 {
+						return hint(3, lc, remove...)
+					}
+				}
+			}
+		}
+	}
+	return false, Hint{}
+}
+
+// candidatesIn returns the cell's candidates that are in the list.
+func (c *Cell) candidatesIn(vs []string) []string {
+	in := c.index().strings(c.candidates & c.index().set(vs))
+	if in == nil {
+		return []string{}
+	}
+	return in
+}
+
+// withCandidates returns a cell outside of the board that has the candidates, using the same symbols as c.
+func (c *Cell) withCandidates(vs []string) *Cell {
+	return &Cell{candidates: c.index().set(vs), symbols: c.symbols}
+}
+
+var EliminatorHiddenUniqueRectangle = func() CandidateEliminator {
+	name := "Hidden Unique Rectangle"
+	r := CandidateEliminator{
+		Name:        name,
+		Description: "A rectangle corner has only the pair. If one of the pair only fits in the rectangle along the opposite corner's row and column, the opposite corner cannot be the other of the pair.",
+		Weight:      4.6,
+		Uniqueness:  true,
+		GameHinter: func(g *Game) (bool, Hint, error) {
+			rows, cols, _ := g.GetSectionedCells()
+			for _, rect := range g.rectangles() {
+				for _, pair := range rect.pairs() {
+					for i, corner := range rect {
+						if corner.Cell.CandidateCount() != 2 {
+							continue
+						}
+						opposite := rect[3-i]
+						for j, symbol := range pair {
+							inRow := candidateLocs(rows[opposite.Loc.Y], symbol)
+							inCol := candidateLocs(cols[opposite.Loc.X], symbol)
+							if len(inRow) != 2 || len(inCol) != 2 {
+								continue
+							}
+							return true, Hint{
+								Loc:                opposite.Loc,
+								CandidatesToRemove: []string{pair[1-j]},
+								Eliminator:         name,
+								cell:               opposite.Cell,
+								Uniqueness: &UniquenessHint{
+									Name:    name,
+									Symbols: pair,
+									Cells:   rect.locs(),
+								},
+							}, nil
+						}
+					}
+				}
+			}
+			return false, Hint{}, nil
+		},
+	}
+
+	r.GameEliminator = GameHinterToEliminator(r.GameHinter)
+	return r
+}()
+
+var EliminatorBUG = func() CandidateEliminator {
+	name := "BUG+1"
+	r := CandidateEliminator{
+		Name:        name,
+		Description: "Every unsolved cell has two candidates except one with three. Without that third candidate the puzzle would have two solutions, so the cell must be the candidate that appears three times in its houses.",
+		Weight:      5.6,
+		Uniqueness:  true,
+		GameHinter: func(g *Game) (bool, Hint, error) {
+			var extra *LocCell
+			for y := range g.Board {
+				for x := range g.Board[y] {
+					cell := g.Board[y][x].Cell
+					switch {
+					case cell.Value != "" || cell.CandidateCount() == 2:
+					case cell.CandidateCount() == 3 && extra == nil:
+						extra = &LocCell{Loc: Loc{X: x, Y: y}, Cell: cell}
+					default:
+						return false, Hint{}, nil
+					}
+				}
+			}
+			if extra == nil {
+				return false, Hint{}, nil
+			}
+
+			rows, cols, groups := g.GetSectionedCells()
+			houses := [][][]LocCell{rows, cols, groups}
+			extraHouses := []int{extra.Loc.Y, extra.Loc.X, g.groupOf(extra.Loc)}
+
+			// The true candidate is the one that shows up three times in each of the cell's houses
+			value := ""
+			for _, symbol := range extra.Cell.Candidates() {
+				three := true
+				for h, i := range extraHouses {
+					three = three && len(candidateLocs(houses[h][i], symbol)) == 3
+				}
+				if three {
+					value = symbol
+				}
+			}
+			if value == "" {
+				return false, Hint{}, nil
+			}
+
+			// Without it every candidate must appear exactly twice in each house
+			for h, cells := range houses {
+				for i, house := range cells {
+					for _, symbol := range g.Symbols {
+						n := len(candidateLocs(house, symbol))
+						if symbol == value && i == extraHouses[h] {
+							n--
+						}
+						if n != 0 && n != 2 {
+							return false, Hint{}, nil
+						}
+					}
+				}
+			}
+
+			return true, Hint{
+				Loc:                extra.Loc,
+				CandidatesToRemove: slices.DeleteFunc(extra.Cell.Candidates(), func(c string) bool { return c == value }),
+				Eliminator:         name,
+				cell:               extra.Cell,
+				Uniqueness: &UniquenessHint{
+					Name:    name,
+					Symbols: []string{value},
+					Cells:   []Loc{extra.Loc},
+				},
+			}, nil
+		},
+	}
+
+	r.GameEliminator = GameHinterToEliminator(r.GameHinter)
+	return r
+}()
@@ -0,0 +1,193 @@
+package sudoku
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEliminatorUniqueRectangle(t *testing.T) {
+	rect := []Loc{{X: 0, Y: 0}, {X: 3, Y: 0}, {X: 0, Y: 1}, {X: 3, Y: 1}}
+	full := []string{"xxxxxxxxx", "xxxxxxxxx", "xxxxxxxxx", "xxxxxxxxx", "xxxxxxxxx", "xxxxxxxxx", "xxxxxxxxx", "xxxxxxxxx", "xxxxxxxxx"}
+
+	tests := []struct {
+		name       string
+		eliminator CandidateEliminator
+		ones       []string // Pattern for where 1 can go
+		candidates map[Loc][]string
+		expected   string
+	}{
+		{
+			name:       "type 1",
+			eliminator: EliminatorUniqueRectangle,
+			ones:       full,
+			candidates: map[Loc][]string{
+				rect[0]: {"1", "2"}, rect[1]: {"1", "2"},
+				rect[2]: {"1", "2"}, rect[3]: {"1", "2", "3"},
+			},
+			expected: "removed candidates (x:3,y:1) [1 2] with Unique Rectangle Type 1 on [1 2] at (x:0,y:0) (x:3,y:0) (x:0,y:1) (x:3,y:1)",
+		},
+		{
+			name:       "type 2",
+			eliminator: EliminatorUniqueRectangle,
+			ones:       full,
+			candidates: map[Loc][]string{
+				rect[0]: {"1", "2"}, rect[1]: {"1", "2"},
+				rect[2]: {"1", "2", "3"}, rect[3]: {"1", "2", "3"},
+			},
+			expected: "removed candidates (x:1,y:1) [3] with Unique Rectangle Type 2 on [1 2] at (x:0,y:0) (x:3,y:0) (x:0,y:1) (x:3,y:1)",
+		},
+		{
+			name:       "type 3",
+			eliminator: EliminatorUniqueRectangle,
+			ones:       full,
+			candidates: map[Loc][]string{
+				rect[0]: {"1", "2"}, rect[1]: {"1", "2"},
+				rect[2]: {"1", "2", "3"}, rect[3]: {"1", "2", "4"},
+				{X: 5, Y: 1}: {"3", "4"},
+			},
+			expected: "removed candidates (x:1,y:1) [3 4] with Unique Rectangle Type 3 on [1 2] at (x:0,y:0) (x:3,y:0) (x:0,y:1) (x:3,y:1)",
+		},
+		{
+			name:       "type 4",
+			eliminator: EliminatorUniqueRectangle,
+			ones: []string{
+				"xxxxxxxxx",
+				"x..x.....",
+				"xxxxxxxxx",
+				"xxxxxxxxx",
+				"xxxxxxxxx",
+				"xxxxxxxxx",
+				"xxxxxxxxx",
+				"xxxxxxxxx",
+				"xxxxxxxxx",
+			},
+			candidates: map[Loc][]string{
+				rect[0]: {"1", "2"}, rect[1]: {"1", "2"},
+				rect[2]: {"1", "2", "3"}, rect[3]: {"1", "2", "4"},
+			},
+			expected: "removed candidates (x:0,y:1) [2] with Unique Rectangle Type 4 on [1 2] at (x:0,y:0) (x:3,y:0) (x:0,y:1) (x:3,y:1)",
+		},
+		{
+			name:       "type 5",
+			eliminator: EliminatorUniqueRectangle,
+			ones:       full,
+			candidates: map[Loc][]string{
+				rect[0]: {"1", "2"}, rect[1]: {"1", "2", "3"},
+				rect[2]: {"1", "2", "3"}, rect[3]: {"1", "2"},
+			},
+			expected: "removed candidates (x:1,y:0) [3] with Unique Rectangle Type 5 on [1 2] at (x:0,y:0) (x:3,y:0) (x:0,y:1) (x:3,y:1)",
+		},
+		{
+			name:       "type 6",
+			eliminator: EliminatorUniqueRectangle,
+			ones: []string{
+				"x..x.....",
+				"x..x.....",
+				".xx.xxxxx",
+				".xx.xxxxx",
+				".xx.xxxxx",
+				".xx.xxxxx",
+				".xx.xxxxx",
+				".xx.xxxxx",
+				".xx.xxxxx",
+			},
+			candidates: map[Loc][]string{
+				rect[0]: {"1", "2"}, rect[1]: {"1", "2", "3"},
+				rect[2]: {"1", "2", "4"}, rect[3]: {"1", "2"},
+			},
+			expected: "removed candidates (x:3,y:0) [1] with Unique Rectangle Type 6 on [1 2] at (x:0,y:0) (x:3,y:0) (x:0,y:1) (x:3,y:1)",
+		},
+		{
+			name:       "hidden",
+			eliminator: EliminatorHiddenUniqueRectangle,
+			ones: []string{
+				"xxxxxxxxx",
+				"x..x.....",
+				"xxx.xxxxx",
+				"xxx.xxxxx",
+				"xxx.xxxxx",
+				"xxx.xxxxx",
+				"xxx.xxxxx",
+				"xxx.xxxxx",
+				"xxx.xxxxx",
+			},
+			candidates: map[Loc][]string{
+				rect[0]: {"1", "2"},
+			},
+			expected: "removed candidates (x:3,y:1) [2] with Hidden Unique Rectangle on [1 2] at (x:0,y:0) (x:3,y:0) (x:0,y:1) (x:3,y:1)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := emptyGame(t)
+			keepSymbol(g, "1", tt.ones)
+			for loc, candidates := range tt.candidates {
+				setCandidates(g, loc, candidates...)
+			}
+
+			ok, h, err := tt.eliminator.GameHinter(g)
+			require.NoError(t, err)
+			require.True(t, ok)
+			assert.Equal(t, tt.expected, h.String())
+		})
+	}
+}
+
+func TestEliminatorBUG(t *testing.T) {
+	// Every cell holds its value from two different solutions, so each candidate appears twice in every house
+	first := [][]string{{"1", "2", "3", "4"}, {"3", "4", "1", "2"}, {"2", "1", "4", "3"}, {"4", "3", "2", "1"}}
+	second := [][]string{{"2", "1", "4", "3"}, {"4", "3", "2", "1"}, {"1", "2", "3", "4"}, {"3", "4", "1", "2"}}
+
+	g := &Game{}
+	cells := [][]string{make([]string, 4), make([]string, 4), make([]string, 4), make([]string, 4)}
+	require.NoError(t, g.Fill(cells, DefaultGroup4x4, []string{"1", "2", "3", "4"}))
+	for y := range g.Board {
+		for x := range g.Board[y] {
+			setCandidates(g, Loc{X: x, Y: y}, first[y][x], second[y][x])
+		}
+	}
+
+	ok, _, err := EliminatorBUG.GameHinter(g)
+	require.NoError(t, err)
+	assert.False(t, ok, "a plain BUG has no way out")
+
+	setCandidates(g, Loc{X: 0, Y: 0}, "1", "2", "3")
+	ok, h, err := EliminatorBUG.GameHinter(g)
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, "removed candidates (x:0,y:0) [1 2] with BUG+1 on [3] at (x:0,y:0)", h.String())
+}
+
+func TestUniquenessIsOptIn(t *testing.T) {
+	g := emptyGame(t)
+	assert.False(t, g.eliminatorEnabled(EliminatorUniqueRectangle))
+	assert.True(t, g.eliminatorEnabled(EliminatorXWing))
+
+	g.AssumeUnique = true
+	assert.True(t, g.eliminatorEnabled(EliminatorUniqueRectangle))
+}
+
+func TestUniquenessSkipsExtraRules(t *testing.T) {
+	tests := []struct {
+		name string
+		game *Game
+	}{
+		{name: "extra houses", game: &Game{Houses: DiagonalHouses(9)}},
+		{name: "cages", game: &Game{Cages: []Cage{{Sum: 3, Cells: []Loc{{X: 0, Y: 0}, {X: 1, Y: 0}}}}}},
+		{name: "lines", game: &Game{Lines: []Line{{Type: LineArrow, Cells: []Loc{{X: 0, Y: 0}, {X: 1, Y: 0}}}}}},
+		{name: "anti-knight", game: &Game{AntiKnight: true}},
+		{name: "non-consecutive", game: &Game{NonConsecutive: true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.game.AssumeUnique = true
+			require.NoError(t, tt.game.FillBasic(emptyCells()))
+			assert.False(t, tt.game.eliminatorEnabled(EliminatorUniqueRectangle))
+			assert.False(t, tt.game.eliminatorEnabled(EliminatorBUG))
+			assert.True(t, tt.game.eliminatorEnabled(EliminatorXWing))
+		})
+	}
+}
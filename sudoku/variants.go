@@ -0,0 +1,65 @@
+package sudoku
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Names of the variants a game can declare in Game.Variants.
+const (
+	VariantFistemafelRing = "Fistemafel Ring"
+	VariantXSudoku        = "X-Sudoku"
+	VariantWindoku        = "Windoku"
+	VariantDisjointGroups = "Disjoint Groups"
+)
+
+// Variant is a ruleset on top of the normal rules. Its eliminators only run on games that declare it.
+type Variant struct {
+	Name        string       `json:"name"`
+	Description string       `json:"description"`
+	Houses      []ExtraHouse `json:"-"` // Added to Game.Houses when the game is filled
+}
+
+// Variants are every ruleset a game can declare. They are all for 9x9 puzzles.
+var Variants = []Variant{
+	{
+		Name:        VariantFistemafelRing,
+		Description: "The 16 cells that ring the center hold the same digits as the 16 cells in the corners.",
+	},
+	{
+		Name:        VariantXSudoku,
+		Description: "Both main diagonals hold every digit once.",
+		Houses:      DiagonalHouses(9),
+	},
+	{
+		Name:        VariantWindoku,
+		Description: "The four shaded 3x3 windows hold every digit once.",
+		Houses:      WindokuHouses9x9,
+	},
+	{
+		Name:        VariantDisjointGroups,
+		Description: "Cells in the same spot of each group hold every digit once.",
+		Houses:      DisjointGroupHouses(DefaultGroup9x9),
+	},
+}
+
+// HasVariant reports whether the game declares the variant.
+func (g *Game) HasVariant(name string) bool {
+	return slices.Contains(g.Variants, name)
+}
+
+// applyVariants adds the houses of every declared variant that the game does not have yet.
+func (g *Game) applyVariants() error {
+	for _, name := range g.Variants {
+		i := slices.IndexFunc(Variants, func(v Variant) bool { return v.Name == name })
+		if i < 0 {
+			return fmt.Errorf("unknown variant '%s'", name)
+		}
+		for _, h := range Variants[i].Houses {
+			if !slices.ContainsFunc(g.Houses, func(e ExtraHouse) bool { return e.Name == h.Name }) {
+				g.Houses = append(g.Houses, h)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package sudoku
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVariantEliminators(t *testing.T) {
+	g := emptyGame(t)
+	assert.False(t, g.eliminatorEnabled(EliminatorFistemafelRing), "variant eliminators are off for normal puzzles")
+	assert.True(t, g.eliminatorEnabled(EliminatorXWing))
+
+	g = &Game{Variants: []string{VariantFistemafelRing}}
+	require.NoError(t, g.FillBasic(emptyCells()))
+	assert.True(t, g.eliminatorEnabled(EliminatorFistemafelRing))
+	assert.True(t, g.clone().eliminatorEnabled(EliminatorFistemafelRing), "copies keep the variants")
+	assert.Empty(t, g.Houses)
+}
+
+func TestVariantHouses(t *testing.T) {
+	g := &Game{Variants: []string{VariantXSudoku, VariantWindoku}}
+	require.NoError(t, g.FillBasic(emptyCells()))
+	require.Len(t, g.Houses, 6)
+	assert.Equal(t, "main diagonal", g.Houses[0].Name)
+	assert.Equal(t, "window 0", g.Houses[2].Name)
+
+	// Filling the game again does not add the houses twice
+	require.NoError(t, g.FillBasic(emptyCells()))
+	assert.Len(t, g.Houses, 6)
+
+	g = &Game{Variants: []string{"Killer"}}
+	assert.ErrorContains(t, g.FillBasic(emptyCells()), "unknown variant")
+}
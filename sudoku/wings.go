@@ -0,0 +1,173 @@
+package sudoku
+
+import (
+	"fmt"
+	"slices"
+)
+
+// WingHint describes a pivot cell and the pincers that together force a candidate out of the cells they all see.
+type WingHint struct {
+	Name    string `json:"name"`
+	Pivot   Loc    `json:"pivot"`
+	Pincers []Loc  `json:"pincers"`
+	Symbol  string `json:"symbol"`
+}
+
+func (w WingHint) String() string {
+	return fmt.Sprintf("with %s pivot %s and pincers %s on %s", w.Name, formatLocs([]Loc{w.Pivot}), formatLocs(w.Pincers), w.Symbol)
+}
+
+func (w WingHint) explain(g *Game) ([]Cause, []House) {
+	return g.causes(slices.Concat([]Loc{w.Pivot}, w.Pincers)), nil
+}
+
+var (
+	EliminatorXYWing = newWingEliminator(
+		"XY-Wing",
+		"A pivot with two candidates sees two pincers that each share one of them and have the same other candidate. Cells seeing both pincers cannot have that candidate.",
+		4.2,
+		wingShape{size: 3, pivotCandidates: []int{2}, pincerMax: 2},
+	)
+	EliminatorXYZWing = newWingEliminator(
+		"XYZ-Wing",
+		"A pivot with three candidates sees two pincers with two of them each. Cells seeing the pivot and both pincers cannot have the candidate they all share.",
+		4.4,
+		wingShape{size: 3, pivotCandidates: []int{3}, pincerMax: 2},
+	)
+	EliminatorWXYZWing = newWingEliminator(
+		"WXYZ-Wing",
+		"Four cells, a pivot and three pincers it sees, hold only four candidates and only one of them can repeat. Cells seeing every copy of that candidate cannot have it.",
+		4.6,
+		wingShape{size: 4, pivotCandidates: []int{2, 3, 4}, pincerMax: 4},
+	)
+)
+
+// wingShape limits which cells can form a wing.
+type wingShape struct {
+	size            int   // Number of cells and candidates in the wing
+	pivotCandidates []int // Allowed candidate counts for the pivot
+	pincerMax       int   // Most candidates a pincer can have
+}
+
+func newWingEliminator(name, description string, weight float64, shape wingShape) CandidateEliminator {
+	r := CandidateEliminator{
+		Name:        name,
+		Description: description,
+		Weight:      weight,
+		GameHinter: func(g *Game) (bool, Hint, error) {
+			ok, h := g.findWing(name, shape)
+			return ok, h, nil
+		},
+	}
+
+	r.GameEliminator = GameHinterToEliminator(r.GameHinter)
+	return r
+}
+
+func (g *Game) findWing(name string, shape wingShape) (bool, Hint) {
+	for y := range g.Board {
+		for x := range g.Board[y] {
+			pivot := LocCell{Loc: Loc{X: x, Y: y}, Cell: g.Board[y][x].Cell}
+			if !slices.Contains(shape.pivotCandidates, pivot.Cell.CandidateCount()) {
+				continue
+			}
+
+			pincers := []LocCell{}
+			for _, lc := range g.Peers(pivot.Loc) {
+				n := lc.Cell.CandidateCount()
+				if n < 2 || n > shape.pincerMax || len(unionCandidates([]LocCell{pivot, lc})) > shape.size {
+					continue
+				}
+				pincers = append(pincers, lc)
+			}
+
+			for _, combo := range getCombinations(pincers, shape.size-1) {
+				cells := append([]LocCell{pivot}, combo...)
+				if len(unionCandidates(cells)) != shape.size {
+					continue
+				}
+
+				symbol, ok := g.onlyUnrestricted(cells)
+				if !ok {
+					continue
+				}
+
+				symbolLocs := []Loc{}
+				for _, lc := range cells {
+					if lc.Cell.HasCandidate(symbol) {
+						symbolLocs = append(symbolLocs, lc.Loc)
+					}
+				}
+				for _, lc := range g.CommonPeers(symbolLocs) {
+					if !lc.Cell.HasCandidate(symbol) || slices.ContainsFunc(cells, func(c LocCell) bool { return c.Loc == lc.Loc }) {
+						continue
+					}
+					pincerLocs := make([]Loc, 0, len(combo))
+					for _, p := range combo {
+						pincerLocs = append(pincerLocs, p.Loc)
+					}
+					return true, Hint{
+						Loc:                lc.Loc,
+						CandidatesToRemove: []string{symbol},
+						Eliminator:         name,
+						cell:               lc.Cell,
+						Wing: &WingHint{
+							Name:    name,
+							Pivot:   pivot.Loc,
+							Pincers: pincerLocs,
+							Symbol:  symbol,
+						},
+					}
+				}
+			}
+		}
+	}
+	return false, Hint{}
+}
+
+// onlyUnrestricted returns the single candidate whose copies in the cells do not all see each other.
+func (g *Game) onlyUnrestricted(cells []LocCell) (string, bool) {
+	unrestricted := []string{}
+	for _, symbol := range unionCandidates(cells) {
+		locs := []Loc{}
+		for _, lc := range cells {
+			if lc.Cell.HasCandidate(symbol) {
+				locs = append(locs, lc.Loc)
+			}
+		}
+		if !g.allSee(locs) {
+			unrestricted = append(unrestricted, symbol)
+		}
+	}
+	if len(unrestricted) != 1 {
+		return "", false
+	}
+	return unrestricted[0], true
+}
+
+// allSee reports whether every pair of locations see each other.
+func (g *Game) allSee(locs []Loc) bool {
+	for i := range locs {
+		for j := i + 1; j < len(locs); j++ {
+			if !g.Sees(locs[i], locs[j]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// unionCandidates returns the sorted candidates found in any of the cells.
+func unionCandidates(cells []LocCell) []string {
+	if len(cells) == 0 {
+		return []string{}
+	}
+	var union CandidateSet
+	for _, lc := range cells {
+		union |= lc.Cell.candidates
+	}
+	if union == 0 {
+		return []string{}
+	}
+	return cells[0].Cell.index().strings(union)
+}
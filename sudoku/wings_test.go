@@ -0,0 +1,97 @@
+package sudoku
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeers(t *testing.T) {
+	g := emptyGame(t)
+
+	peers := g.Peers(Loc{X: 4, Y: 4})
+	assert.Len(t, peers, 20)
+	for _, lc := range peers {
+		assert.True(t, g.Sees(Loc{X: 4, Y: 4}, lc.Loc), lc.Loc)
+	}
+	assert.False(t, g.Sees(Loc{X: 4, Y: 4}, Loc{X: 4, Y: 4}))
+	assert.False(t, g.Sees(Loc{X: 0, Y: 0}, Loc{X: 4, Y: 4}))
+
+	common := []Loc{}
+	for _, lc := range g.CommonPeers([]Loc{{X: 4, Y: 0}, {X: 0, Y: 4}}) {
+		common = append(common, lc.Loc)
+	}
+	assert.ElementsMatch(t, []Loc{{X: 0, Y: 0}, {X: 4, Y: 4}}, common)
+}
+
+func TestEliminatorWings(t *testing.T) {
+	tests := []struct {
+		name       string
+		eliminator CandidateEliminator
+		cells      map[Loc][]string
+		expected   []string
+	}{
+		{
+			name:       "XY-Wing",
+			eliminator: EliminatorXYWing,
+			cells: map[Loc][]string{
+				{X: 4, Y: 4}: {"1", "2"},
+				{X: 4, Y: 0}: {"1", "3"},
+				{X: 0, Y: 4}: {"2", "3"},
+			},
+			expected: []string{
+				"removed candidates (x:0,y:0) [3] with XY-Wing pivot (x:4,y:4) and pincers (x:4,y:0) (x:0,y:4) on 3",
+			},
+		},
+		{
+			name:       "XYZ-Wing",
+			eliminator: EliminatorXYZWing,
+			cells: map[Loc][]string{
+				{X: 4, Y: 4}: {"1", "2", "3"},
+				{X: 4, Y: 0}: {"1", "3"},
+				{X: 3, Y: 3}: {"2", "3"},
+			},
+			expected: []string{
+				"removed candidates (x:4,y:3) [3] with XYZ-Wing pivot (x:4,y:4) and pincers (x:4,y:0) (x:3,y:3) on 3",
+				"removed candidates (x:4,y:5) [3] with XYZ-Wing pivot (x:4,y:4) and pincers (x:4,y:0) (x:3,y:3) on 3",
+			},
+		},
+		{
+			name:       "WXYZ-Wing",
+			eliminator: EliminatorWXYZWing,
+			cells: map[Loc][]string{
+				{X: 4, Y: 4}: {"1", "2", "3", "4"},
+				{X: 3, Y: 3}: {"1", "4"},
+				{X: 5, Y: 5}: {"2", "4"},
+				{X: 4, Y: 8}: {"3", "4"},
+			},
+			expected: []string{
+				"removed candidates (x:4,y:3) [4] with WXYZ-Wing pivot (x:4,y:4) and pincers (x:3,y:3) (x:5,y:5) (x:4,y:8) on 4",
+				"removed candidates (x:4,y:5) [4] with WXYZ-Wing pivot (x:4,y:4) and pincers (x:3,y:3) (x:5,y:5) (x:4,y:8) on 4",
+			},
+		},
+		{
+			name:       "XY-Wing pincers must share a candidate",
+			eliminator: EliminatorXYWing,
+			cells: map[Loc][]string{
+				{X: 4, Y: 4}: {"1", "2"},
+				{X: 4, Y: 0}: {"1", "3"},
+				{X: 0, Y: 4}: {"2", "4"},
+			},
+			expected: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := emptyGame(t)
+			for loc, candidates := range tt.cells {
+				setCandidates(g, loc, candidates...)
+			}
+
+			assert.ElementsMatch(t, tt.expected, collectChanges(t, g, tt.eliminator))
+			require.NoError(t, g.BadBoard())
+		})
+	}
+}